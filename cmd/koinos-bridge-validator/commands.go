@@ -0,0 +1,1173 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	koinosUtil "github.com/koinos/koinos-util-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	flag "github.com/spf13/pflag"
+)
+
+const (
+	chainOption  = "chain"
+	statusOption = "status"
+	fileOption   = "file"
+	fromOption   = "from"
+	toOption     = "to"
+	adminOption  = "admin-url"
+
+	chainEthereum = "ethereum"
+	chainKoinos   = "koinos"
+)
+
+// command is an admin subcommand
+type command struct {
+	usage       string
+	description string
+	options     []string
+	run         func(flags *flag.FlagSet, baseDir string, args []string) error
+}
+
+var optionsDescriptions = map[string]string{
+	chainOption:  "the chain (koinos or the name of an EVM chain, ethereum when evm-chains is not configured)",
+	statusOption: "the transaction status",
+	fileOption:   "the file path",
+	fromOption:   "the first block",
+	toOption:     "the last block",
+	adminOption:  "the admin API url of the running validator (defaults to admin-api-url from the config)",
+}
+
+var commands = map[string]map[string]*command{
+	"tx": {
+		"get": {
+			usage:       "tx get --chain <koinos|evm chain> <key>",
+			options:     []string{chainOption},
+			description: "print a transaction",
+			run:         txGetCommand,
+		},
+		"list": {
+			usage:       "tx list --chain <koinos|evm chain> [--status <status>]",
+			options:     []string{chainOption, statusOption},
+			description: "list the transactions, optionally filtered by status",
+			run:         txListCommand,
+		},
+		"set-status": {
+			usage:       "tx set-status --chain <koinos|evm chain> <key> <status>",
+			options:     []string{chainOption},
+			description: "overwrite the status of a transaction",
+			run:         txSetStatusCommand,
+		},
+	},
+	"archive": {
+		"get": {
+			usage:       "archive get --chain <koinos|evm chain> <key>",
+			options:     []string{chainOption},
+			description: "print an archived transaction with its history",
+			run:         archiveGetCommand,
+		},
+	},
+	"metadata": {
+		"show": {
+			usage:       "metadata show",
+			description: "print the streamers checkpoints",
+			run:         metadataShowCommand,
+		},
+		"set-block": {
+			usage:       "metadata set-block --chain <koinos|evm chain> <block>",
+			options:     []string{chainOption},
+			description: "set the last block parsed by a streamer",
+			run:         metadataSetBlockCommand,
+		},
+	},
+	"export": {
+		"": {
+			usage:       "export [--file <path>]",
+			options:     []string{fileOption},
+			description: "export all the databases as JSON lines (stdout by default)",
+			run:         exportCommand,
+		},
+	},
+	"import": {
+		"": {
+			usage:       "import [--file <path>]",
+			options:     []string{fileOption},
+			description: "import JSON lines produced by export (stdin by default)",
+			run:         importCommand,
+		},
+	},
+	"rescan": {
+		"": {
+			usage:       "rescan --chain <koinos|evm chain> --from <block> --to <block> [--admin-url <url>]",
+			options:     []string{chainOption, fromOption, toOption, adminOption},
+			description: "rescan a block range with the running validator and print the events found",
+			run:         rescanCommand,
+		},
+	},
+	"audit": {
+		"export": {
+			usage:       "audit export [--file <path>]",
+			options:     []string{fileOption},
+			description: "export the signing audit log as JSON lines (stdout by default)",
+			run:         auditExportCommand,
+		},
+		"verify": {
+			usage:       "audit verify",
+			description: "verify the hash chain and the signatures of the signing audit log",
+			run:         auditVerifyCommand,
+		},
+	},
+	"backup": {
+		"create": {
+			usage:       "backup create --file <path> [--admin-url <url>]",
+			options:     []string{fileOption, adminOption},
+			description: "take an online backup of the databases of the running validator and verify it",
+			run:         backupCreateCommand,
+		},
+		"verify": {
+			usage:       "backup verify --file <path>",
+			options:     []string{fileOption},
+			description: "verify the integrity and the version of a backup",
+			run:         backupVerifyCommand,
+		},
+		"restore": {
+			usage:       "backup restore --file <path> [--admin-url <url>]",
+			options:     []string{fileOption, adminOption},
+			description: "verify a backup and replace the databases it contains with its content",
+			run:         backupRestoreCommand,
+		},
+	},
+	"validate-config": {
+		"": {
+			usage:       "validate-config",
+			description: "check the config, the chain ids and the contracts of the RPCs and that the peers are reachable",
+			run:         validateConfigCommand,
+		},
+	},
+	"compact": {
+		"": {
+			usage:       "compact",
+			description: "compact the databases and garbage collect their value logs",
+			run:         compactCommand,
+		},
+	},
+}
+
+// exportedRecord is a database record as exported by the export command
+type exportedRecord struct {
+	Store string          `json:"store"`
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n  %s [--basedir <dir>]\n  %s <command> [--basedir <dir>] [options]\n\nCommands (the validator must be stopped, except for rescan, backup create and validate-config):\n", os.Args[0], os.Args[0])
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(os.Stderr, 0, 4, 2, ' ', 0)
+	for _, name := range names {
+		subNames := make([]string, 0, len(commands[name]))
+		for subName := range commands[name] {
+			subNames = append(subNames, subName)
+		}
+		sort.Strings(subNames)
+
+		for _, subName := range subNames {
+			cmd := commands[name][subName]
+			fmt.Fprintf(w, "  %s\t%s\n", cmd.usage, cmd.description)
+		}
+	}
+	w.Flush()
+
+	fmt.Fprintf(os.Stderr, "\nOptions:\n")
+	flag.PrintDefaults()
+}
+
+// runCommand runs the admin command described by args and returns the process exit code
+func runCommand(args []string) int {
+	subCommands, found := commands[args[0]]
+	if !found {
+		fmt.Fprintf(os.Stderr, "unknown command %s\n\n", args[0])
+		usage()
+		return 2
+	}
+
+	name := args[0]
+	args = args[1:]
+
+	cmd, found := subCommands[""]
+	if !found {
+		if len(args) == 0 || subCommands[args[0]] == nil {
+			fmt.Fprintf(os.Stderr, "unknown or missing %s subcommand\n\n", name)
+			usage()
+			return 2
+		}
+
+		name += " " + args[0]
+		cmd = subCommands[args[0]]
+		args = args[1:]
+	}
+
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	baseDir := flags.StringP(basedirOption, "d", basedirDefault, "the base directory")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s %s\n  %s\n\n", os.Args[0], cmd.usage, cmd.description)
+		flags.PrintDefaults()
+	}
+
+	for _, option := range cmd.options {
+		flags.String(option, "", optionsDescriptions[option])
+	}
+
+	err := flags.Parse(args)
+	if err != nil {
+		return 2
+	}
+
+	dir, err := initBaseDir(*baseDir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return 1
+	}
+
+	err = cmd.run(flags, dir, flags.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", name, err.Error())
+		return 1
+	}
+
+	return 0
+}
+
+// getEvmChainNames returns the names of the EVM chains configured for baseDir
+func getEvmChainNames(baseDir string) ([]string, error) {
+	yamlConfig := util.InitYamlConfig(baseDir)
+
+	evmChainsConfig, err := getEvmChainsConfig(&yamlConfig.Bridge)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, chainConfig := range evmChainsConfig {
+		names = append(names, chainConfig.Name)
+	}
+
+	return names, nil
+}
+
+// getChain returns the chain option, koinos or a configured EVM chain
+func getChain(flags *flag.FlagSet, baseDir string) (string, error) {
+	chain, _ := flags.GetString(chainOption)
+	if chain == "eth" {
+		chain = chainEthereum
+	}
+
+	if chain == chainKoinos {
+		return chain, nil
+	}
+
+	evmChainNames, err := getEvmChainNames(baseDir)
+	if err != nil {
+		return "", err
+	}
+
+	for _, name := range evmChainNames {
+		if name == chain {
+			return chain, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid --%s \"%s\", expected koinos or one of %s", chainOption, chain, strings.Join(evmChainNames, ", "))
+}
+
+// getDbNames returns the names of all the databases configured for baseDir
+func getDbNames(baseDir string) ([]string, error) {
+	evmChainNames, err := getEvmChainNames(baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	dbNames := []string{metadataDbName, koinosTransactionsDbName}
+	for _, name := range evmChainNames {
+		dbNames = append(dbNames, transactionsDbName(name))
+	}
+
+	return append(dbNames, processedEventsDbName, pendingDbName, signingAuditLogDbName, misbehaviorsDbName, historyDbName, archiveIndexDbName), nil
+}
+
+func parseStatus(status string) (bridge_pb.TransactionStatus, error) {
+	value, found := bridge_pb.TransactionStatus_value[status]
+	// the transactions not final yet are not stored
+	if !found || value == int32(bridge_pb.TransactionStatus_pending_confirmations) {
+		return 0, fmt.Errorf("invalid status %s", status)
+	}
+
+	return bridge_pb.TransactionStatus(value), nil
+}
+
+// withStorage opens the databases with the storage configured for baseDir and calls fn with it
+func withStorage(baseDir string, fn func(dbStorage *storage) error) error {
+	yamlConfig := util.InitYamlConfig(baseDir)
+
+	dbStorage, err := openStorage(baseDir, yamlConfig.Bridge.Storage)
+	if err != nil {
+		return err
+	}
+	defer dbStorage.close()
+
+	return fn(dbStorage)
+}
+
+// withTransactionsStore opens the transactions database of the chain option and calls fn with it
+func withTransactionsStore(flags *flag.FlagSet, baseDir string, fn func(txStore *store.TransactionsStore) error) error {
+	chain, err := getChain(flags, baseDir)
+	if err != nil {
+		return err
+	}
+
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		backend, err := dbStorage.open(transactionsDbName(chain))
+		if err != nil {
+			return err
+		}
+
+		historyBackend, err := dbStorage.open(historyDbName)
+		if err != nil {
+			return err
+		}
+
+		txStore := store.NewTransactionsStore(backend)
+		txStore.SetHistory(store.NewTransactionHistoryStore(historyBackend), chain)
+
+		return fn(txStore)
+	})
+}
+
+// withMetadataStore opens the metadata database and calls fn with it
+func withMetadataStore(baseDir string, fn func(metadataStore *store.MetadataStore) error) error {
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		backend, err := dbStorage.open(metadataDbName)
+		if err != nil {
+			return err
+		}
+
+		return fn(store.NewMetadataStore(backend))
+	})
+}
+
+func txGetCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected a transaction key")
+	}
+
+	return withTransactionsStore(flags, baseDir, func(txStore *store.TransactionsStore) error {
+		tx, err := txStore.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		if tx == nil {
+			return fmt.Errorf("transaction %s does not exist", args[0])
+		}
+
+		m := protojson.MarshalOptions{
+			EmitUnpopulated: true,
+			Multiline:       true,
+		}
+
+		jsonBytes, err := m.Marshal(tx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(jsonBytes))
+		return nil
+	})
+}
+
+func archiveGetCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected a transaction key")
+	}
+
+	chain, err := getChain(flags, baseDir)
+	if err != nil {
+		return err
+	}
+
+	yamlConfig := util.InitYamlConfig(baseDir)
+
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		backend, err := dbStorage.open(archiveIndexDbName)
+		if err != nil {
+			return err
+		}
+
+		archiveStore, err := store.NewArchiveStore(
+			util.GetStringOption(yamlConfig.Bridge.Retention.ArchiveDir, path.Join(koinosUtil.GetAppDir(baseDir, appName), archiveDir)),
+			backend,
+		)
+		if err != nil {
+			return err
+		}
+
+		archivedTx, err := archiveStore.Get(chain, args[0])
+		if err != nil {
+			return err
+		}
+
+		if archivedTx == nil {
+			return fmt.Errorf("transaction %s is not archived", args[0])
+		}
+
+		m := protojson.MarshalOptions{
+			EmitUnpopulated: true,
+			Multiline:       true,
+		}
+
+		jsonBytes, err := m.Marshal(archivedTx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(jsonBytes))
+		return nil
+	})
+}
+
+func txListCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	statusStr, _ := flags.GetString(statusOption)
+
+	var status bridge_pb.TransactionStatus
+	var err error
+	if statusStr != "" {
+		status, err = parseStatus(statusStr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return withTransactionsStore(flags, baseDir, func(txStore *store.TransactionsStore) error {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "KEY\tSTATUS\tBLOCK\tAMOUNT\tSIGNATURES\tEXPIRATION")
+
+		err := txStore.Iterate(func(key string, tx *bridge_pb.Transaction) error {
+			if statusStr != "" && tx.Status != status {
+				return nil
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%d\t%d\n", key, tx.Status, tx.BlockNumber, tx.Amount, len(tx.Signatures), tx.Expiration)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		return w.Flush()
+	})
+}
+
+func txSetStatusCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected a transaction key and a status")
+	}
+
+	status, err := parseStatus(args[1])
+	if err != nil {
+		return err
+	}
+
+	return withTransactionsStore(flags, baseDir, func(txStore *store.TransactionsStore) error {
+		tx, err := txStore.Get(args[0])
+		if err != nil {
+			return err
+		}
+
+		if tx == nil {
+			return fmt.Errorf("transaction %s does not exist", args[0])
+		}
+
+		fmt.Printf("transaction %s: %s -> %s\n", args[0], tx.Status, status)
+		tx.Status = status
+
+		return txStore.Update(context.Background(), args[0], tx, &bridge_pb.TransactionTransition{Cause: bridge_pb.TransitionCause_admin, Details: "tx set-status"})
+	})
+}
+
+func metadataShowCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	return withMetadataStore(baseDir, func(metadataStore *store.MetadataStore) error {
+		metadata, err := metadataStore.Get()
+		if err != nil {
+			return err
+		}
+
+		m := protojson.MarshalOptions{
+			EmitUnpopulated: true,
+			Multiline:       true,
+		}
+
+		jsonBytes, err := m.Marshal(metadata)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(jsonBytes))
+		return nil
+	})
+}
+
+func metadataSetBlockCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected a block number")
+	}
+
+	block, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	chain, err := getChain(flags, baseDir)
+	if err != nil {
+		return err
+	}
+
+	return withMetadataStore(baseDir, func(metadataStore *store.MetadataStore) error {
+		metadata, err := metadataStore.Get()
+		if err != nil {
+			return err
+		}
+
+		if chain == chainKoinos {
+			fmt.Printf("last Koinos block parsed: %d -> %d\n", metadata.LastKoinosBlockParsed, block)
+			metadata.LastKoinosBlockParsed = block
+		} else {
+			fmt.Printf("last %s block parsed: %d -> %d\n", chain, streamer.GetEvmLastBlockParsed(metadata, chain), block)
+			streamer.SetEvmLastBlockParsed(metadata, chain, block)
+		}
+
+		return metadataStore.Put(metadata)
+	})
+}
+
+func exportCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	filePath, _ := flags.GetString(fileOption)
+
+	var out io.Writer = os.Stdout
+	if filePath != "" {
+		file, err := os.Create(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	writer := bufio.NewWriter(out)
+	defer writer.Flush()
+
+	encoder := json.NewEncoder(writer)
+
+	dbNames, err := getDbNames(baseDir)
+	if err != nil {
+		return err
+	}
+
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		metadataBackend, err := dbStorage.open(metadataDbName)
+		if err != nil {
+			return err
+		}
+
+		metadata, err := store.NewMetadataStore(metadataBackend).Get()
+		if err != nil {
+			return err
+		}
+
+		value, err := protojson.Marshal(metadata)
+		if err != nil {
+			return err
+		}
+
+		err = encoder.Encode(&exportedRecord{Store: metadataDbName, Key: store.MetadaKey, Value: value})
+		if err != nil {
+			return err
+		}
+
+		for _, dbName := range dbNames {
+			if !strings.HasSuffix(dbName, transactionsDbSuffix) {
+				continue
+			}
+
+			backend, err := dbStorage.open(dbName)
+			if err != nil {
+				return err
+			}
+
+			err = store.NewTransactionsStore(backend).Iterate(func(key string, tx *bridge_pb.Transaction) error {
+				value, err := protojson.Marshal(tx)
+				if err != nil {
+					return err
+				}
+
+				return encoder.Encode(&exportedRecord{Store: dbName, Key: key, Value: value})
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		processedEventsBackend, err := dbStorage.open(processedEventsDbName)
+		if err != nil {
+			return err
+		}
+
+		err = store.NewProcessedEventsStore(processedEventsBackend).Iterate(func(key string, event *bridge_pb.ProcessedEvent) error {
+			value, err := protojson.Marshal(event)
+			if err != nil {
+				return err
+			}
+
+			return encoder.Encode(&exportedRecord{Store: processedEventsDbName, Key: key, Value: value})
+		})
+		if err != nil {
+			return err
+		}
+
+		pendingBackend, err := dbStorage.open(pendingDbName)
+		if err != nil {
+			return err
+		}
+
+		err = store.NewPendingTransactionsStore(pendingBackend).Iterate(func(key string, pendingTx *bridge_pb.PendingTransaction) error {
+			value, err := protojson.Marshal(pendingTx)
+			if err != nil {
+				return err
+			}
+
+			return encoder.Encode(&exportedRecord{Store: pendingDbName, Key: key, Value: value})
+		})
+		if err != nil {
+			return err
+		}
+
+		misbehaviorsBackend, err := dbStorage.open(misbehaviorsDbName)
+		if err != nil {
+			return err
+		}
+
+		err = store.NewMisbehaviorsStore(misbehaviorsBackend).Iterate("", func(key string, evidence *bridge_pb.MisbehaviorEvidence) error {
+			value, err := protojson.Marshal(evidence)
+			if err != nil {
+				return err
+			}
+
+			return encoder.Encode(&exportedRecord{Store: misbehaviorsDbName, Key: key, Value: value})
+		})
+		if err != nil {
+			return err
+		}
+
+		historyBackend, err := dbStorage.open(historyDbName)
+		if err != nil {
+			return err
+		}
+
+		err = store.NewTransactionHistoryStore(historyBackend).Iterate("", func(key string, transition *bridge_pb.TransactionTransition) error {
+			value, err := protojson.Marshal(transition)
+			if err != nil {
+				return err
+			}
+
+			return encoder.Encode(&exportedRecord{Store: historyDbName, Key: key, Value: value})
+		})
+		if err != nil {
+			return err
+		}
+
+		signingAuditLog, err := openSigningAuditLog(dbStorage)
+		if err != nil {
+			return err
+		}
+
+		return signingAuditLog.Iterate(func(entry *bridge_pb.SigningAuditEntry) error {
+			value, err := protojson.Marshal(entry)
+			if err != nil {
+				return err
+			}
+
+			return encoder.Encode(&exportedRecord{Store: signingAuditLogDbName, Key: fmt.Sprint(entry.Sequence), Value: value})
+		})
+	})
+}
+
+func importCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	filePath, _ := flags.GetString(fileOption)
+
+	var in io.Reader = os.Stdin
+	if filePath != "" {
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+	}
+
+	dbNames, err := getDbNames(baseDir)
+	if err != nil {
+		return err
+	}
+
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		backends := make(map[string]store.Backend)
+		txStores := make(map[string]*store.TransactionsStore)
+
+		for _, dbName := range dbNames {
+			backend, err := dbStorage.open(dbName)
+			if err != nil {
+				return err
+			}
+			backends[dbName] = backend
+
+			if strings.HasSuffix(dbName, transactionsDbSuffix) {
+				txStores[dbName] = store.NewTransactionsStore(backend)
+			}
+		}
+
+		metadataStore := store.NewMetadataStore(backends[metadataDbName])
+		processedEventsStore := store.NewProcessedEventsStore(backends[processedEventsDbName])
+		pendingTxStore := store.NewPendingTransactionsStore(backends[pendingDbName])
+		misbehaviorsStore := store.NewMisbehaviorsStore(backends[misbehaviorsDbName])
+		historyStore := store.NewTransactionHistoryStore(backends[historyDbName])
+
+		signingAuditLog, err := store.NewSigningAuditLogStore(backends[signingAuditLogDbName])
+		if err != nil {
+			return err
+		}
+
+		decoder := json.NewDecoder(bufio.NewReader(in))
+		count := 0
+
+		for {
+			record := &exportedRecord{}
+			err := decoder.Decode(record)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+
+			if record.Store == metadataDbName {
+				metadata := &bridge_pb.Metadata{}
+				err = protojson.Unmarshal(record.Value, metadata)
+				if err != nil {
+					return err
+				}
+
+				err = metadataStore.Put(metadata)
+			} else if txStore, found := txStores[record.Store]; found {
+				tx := &bridge_pb.Transaction{}
+				err = protojson.Unmarshal(record.Value, tx)
+				if err != nil {
+					return err
+				}
+
+				err = txStore.Put(record.Key, tx)
+			} else if record.Store == processedEventsDbName {
+				event := &bridge_pb.ProcessedEvent{}
+				err = protojson.Unmarshal(record.Value, event)
+				if err != nil {
+					return err
+				}
+
+				err = processedEventsStore.Put(record.Key, event)
+			} else if record.Store == pendingDbName {
+				pendingTx := &bridge_pb.PendingTransaction{}
+				err = protojson.Unmarshal(record.Value, pendingTx)
+				if err != nil {
+					return err
+				}
+
+				err = pendingTxStore.Put(record.Key, pendingTx)
+			} else if record.Store == misbehaviorsDbName {
+				evidence := &bridge_pb.MisbehaviorEvidence{}
+				err = protojson.Unmarshal(record.Value, evidence)
+				if err != nil {
+					return err
+				}
+
+				err = misbehaviorsStore.Put(record.Key, evidence)
+			} else if record.Store == historyDbName {
+				transition := &bridge_pb.TransactionTransition{}
+				err = protojson.Unmarshal(record.Value, transition)
+				if err != nil {
+					return err
+				}
+
+				err = historyStore.Put(record.Key, transition)
+			} else if record.Store == signingAuditLogDbName {
+				entry := &bridge_pb.SigningAuditEntry{}
+				err = protojson.Unmarshal(record.Value, entry)
+				if err != nil {
+					return err
+				}
+
+				err = signingAuditLog.Import(entry)
+			} else {
+				err = fmt.Errorf("unknown store %s for key %s", record.Store, record.Key)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			count++
+		}
+
+		fmt.Printf("imported %d records\n", count)
+		return nil
+	})
+}
+
+func compactCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	dbNames, err := getDbNames(baseDir)
+	if err != nil {
+		return err
+	}
+
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		for _, dbName := range dbNames {
+			_, err := dbStorage.open(dbName)
+			if err != nil {
+				return err
+			}
+		}
+
+		return dbStorage.compact()
+	})
+}
+
+// openSigningAuditLog opens the signing audit log database of dbStorage
+func openSigningAuditLog(dbStorage *storage) (*store.SigningAuditLogStore, error) {
+	backend, err := dbStorage.open(signingAuditLogDbName)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.NewSigningAuditLogStore(backend)
+}
+
+func auditExportCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	filePath, _ := flags.GetString(fileOption)
+
+	var out io.Writer = os.Stdout
+	if filePath != "" {
+		file, err := os.Create(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	writer := bufio.NewWriter(out)
+	defer writer.Flush()
+
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		signingAuditLog, err := openSigningAuditLog(dbStorage)
+		if err != nil {
+			return err
+		}
+
+		return signingAuditLog.Iterate(func(entry *bridge_pb.SigningAuditEntry) error {
+			jsonBytes, err := protojson.Marshal(entry)
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(writer, string(jsonBytes))
+			return err
+		})
+	})
+}
+
+func auditVerifyCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		signingAuditLog, err := openSigningAuditLog(dbStorage)
+		if err != nil {
+			return err
+		}
+
+		signatures := make(map[string]uint64)
+
+		count, err := signingAuditLog.Verify(func(entry *bridge_pb.SigningAuditEntry) error {
+			signatures[entry.KeyType+" "+entry.Signer]++
+			return util.VerifySigningAuditEntrySignature(entry)
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%d entries verified\n", count)
+
+		head := signingAuditLog.Head()
+		if head != nil {
+			fmt.Printf("last entry: %d, %s, hash %s\n", head.Sequence, time.UnixMilli(int64(head.Timestamp)).UTC().Format(time.RFC3339), hex.EncodeToString(head.EntryHash))
+		}
+
+		signers := []string{}
+		for signer := range signatures {
+			signers = append(signers, signer)
+		}
+		sort.Strings(signers)
+
+		for _, signer := range signers {
+			fmt.Printf("%s: %d signatures\n", signer, signatures[signer])
+		}
+
+		return nil
+	})
+}
+
+// getAdminApiUrl returns the admin API url from the admin-url option or from the config
+func getAdminApiUrl(flags *flag.FlagSet, baseDir string) string {
+	adminUrl, _ := flags.GetString(adminOption)
+
+	if adminUrl == "" {
+		yamlConfig := util.InitYamlConfig(baseDir)
+		adminUrl = util.GetStringOption(yamlConfig.Bridge.AdminApiUrl, adminApiUrlDefault)
+	}
+
+	if strings.HasPrefix(adminUrl, ":") {
+		adminUrl = "127.0.0.1" + adminUrl
+	}
+
+	if !strings.HasPrefix(adminUrl, "http://") && !strings.HasPrefix(adminUrl, "https://") {
+		adminUrl = "http://" + adminUrl
+	}
+
+	return strings.TrimSuffix(adminUrl, "/")
+}
+
+func rescanCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	chain, err := getChain(flags, baseDir)
+	if err != nil {
+		return err
+	}
+
+	fromStr, _ := flags.GetString(fromOption)
+	toStr, _ := flags.GetString(toOption)
+
+	fromBlock, err := strconv.ParseUint(fromStr, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid --%s: %s", fromOption, err)
+	}
+
+	toBlock, err := strconv.ParseUint(toStr, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid --%s: %s", toOption, err)
+	}
+
+	adminUrl := getAdminApiUrl(flags, baseDir)
+	client := http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	job := &streamer.RescanJob{}
+	err = callAdminApi(&client, http.MethodPost, fmt.Sprintf("%s/Rescan?Chain=%s&FromBlock=%d&ToBlock=%d", adminUrl, chain, fromBlock, toBlock), job)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "rescan %s started\n", job.Id)
+
+	for job.Status == streamer.RescanStatusRunning {
+		time.Sleep(time.Second)
+
+		err = callAdminApi(&client, http.MethodGet, fmt.Sprintf("%s/GetRescan?Id=%s", adminUrl, job.Id), job)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "rescan %s: block %d / %d, %d events found\n", job.Id, job.LastBlockParsed, job.ToBlock, len(job.Events))
+	}
+
+	jsonBytes, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(jsonBytes))
+
+	if job.Status == streamer.RescanStatusFailed {
+		return fmt.Errorf("rescan failed: %s", job.Error)
+	}
+
+	return nil
+}
+
+func backupCreateCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	filePath, _ := flags.GetString(fileOption)
+	if filePath == "" {
+		return fmt.Errorf("missing --%s", fileOption)
+	}
+
+	res, err := http.Get(getAdminApiUrl(flags, baseDir) + "/Backup")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("admin API error %d: %s", res.StatusCode, string(body))
+	}
+
+	// the backup only replaces the file once complete
+	tmpPath := filePath + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	_, err = io.Copy(file, res.Body)
+	file.Close()
+	if err != nil {
+		return err
+	}
+
+	err = verifyBackupFile(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, filePath)
+}
+
+func backupVerifyCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	filePath, _ := flags.GetString(fileOption)
+	if filePath == "" {
+		return fmt.Errorf("missing --%s", fileOption)
+	}
+
+	return verifyBackupFile(filePath)
+}
+
+func backupRestoreCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	filePath, _ := flags.GetString(fileOption)
+	if filePath == "" {
+		return fmt.Errorf("missing --%s", fileOption)
+	}
+
+	dbNames, err := getDbNames(baseDir)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return withStorage(baseDir, func(dbStorage *storage) error {
+		restorer := newBackupRestorer(dbStorage, dbNames, getAdminApiUrl(flags, baseDir))
+
+		// checked again before swapping the databases
+		err := restorer.CheckStopped(dbNames)
+		if err != nil {
+			return err
+		}
+
+		header, trailer, err := store.RestoreBackup(file, restorer)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("restored %d records of %d databases from the backup of %s\n", trailer.Records, len(header.Databases), time.UnixMilli(int64(header.CreatedAt)).UTC().Format(time.RFC3339))
+		return nil
+	})
+}
+
+// verifyBackupFile verifies the backup file and prints its description
+func verifyBackupFile(filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	header, trailer, err := store.VerifyBackup(file)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("backup %s verified: version %d, created %s, %d records, databases %s, checksum %s\n",
+		filePath, header.Version, time.UnixMilli(int64(header.CreatedAt)).UTC().Format(time.RFC3339), trailer.Records, strings.Join(header.Databases, ", "), trailer.Checksum)
+	return nil
+}
+
+// callAdminApi calls an admin API endpoint and decodes the JSON response into result
+func callAdminApi(client *http.Client, method string, url string, result interface{}) error {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("admin API returned status %d: %s", res.StatusCode, string(body))
+	}
+
+	return json.Unmarshal(body, result)
+}
+
+func validateConfigCommand(flags *flag.FlagSet, baseDir string, args []string) error {
+	yamlConfig := util.InitYamlConfig(baseDir)
+
+	report := runPreflightChecks(context.Background(), &yamlConfig.Bridge)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for _, result := range report.Results {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", result.Severity, result.Check, result.Message)
+	}
+	w.Flush()
+
+	return report.Err()
+}
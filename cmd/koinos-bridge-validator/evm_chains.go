@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+// getEvmChainsConfig returns the EVM chains of the config with their defaults, when evm-chains is empty
+// the ethereum-* options and the tokens configure a single chain named "ethereum"
+func getEvmChainsConfig(bridgeConfig *util.BridgeConfig) ([]util.EvmChainConfig, error) {
+	if len(bridgeConfig.EvmChains) == 0 {
+		finality := util.GetStringOption(bridgeConfig.EthereumFinality, streamer.FinalityConfirmations)
+		if !streamer.IsValidFinality(finality) {
+			return nil, fmt.Errorf("invalid ethereum-finality \"%s\"", finality)
+		}
+
+		return []util.EvmChainConfig{
+			{
+				Name:            streamer.ChainEthereum,
+				Rpc:             util.GetStringOption(bridgeConfig.EthereumRpc, ethRPCDefault),
+				ChainId:         bridgeConfig.EthereumChainId,
+				Contract:        util.GetStringOption(bridgeConfig.EthereumContract, emptyDefault),
+				BlockStart:      util.GetUInt64Option(bridgeConfig.EthereumBlockStart, ethBlockStartDefault),
+				MaxBlocksStream: util.GetUInt64Option(bridgeConfig.EthereumMaxBlocksStream, ethMaxBlocksToStreamDefault),
+				Confirmations:   util.GetUInt64Option(bridgeConfig.EthereumConfirmations, ethConfirmationsDefault),
+				Finality:        finality,
+				PollingTime:     util.GetUIntOption(bridgeConfig.EthereumPollingTime, ethPollingTimeDefault),
+				CatchUpDistance: util.GetUInt64Option(bridgeConfig.EthereumCatchUpDistance, ethCatchUpDistanceDefault),
+				Tokens:          bridgeConfig.Tokens,
+			},
+		}, nil
+	}
+
+	evmChains := []util.EvmChainConfig{}
+	names := make(map[string]bool)
+	chainIds := make(map[uint64]bool)
+
+	for _, chainConfig := range bridgeConfig.EvmChains {
+		if chainConfig.Name == "" || chainConfig.Name == streamer.ChainKoinos {
+			return nil, fmt.Errorf("invalid evm chain name \"%s\"", chainConfig.Name)
+		}
+
+		if names[chainConfig.Name] {
+			return nil, fmt.Errorf("evm chain %s is configured twice", chainConfig.Name)
+		}
+		names[chainConfig.Name] = true
+
+		if chainIds[chainConfig.ChainId] {
+			if chainConfig.ChainId == 0 {
+				return nil, fmt.Errorf("only one evm chain can omit its chain-id")
+			}
+			return nil, fmt.Errorf("evm chain id %d is configured twice", chainConfig.ChainId)
+		}
+		chainIds[chainConfig.ChainId] = true
+
+		if chainConfig.Rpc == "" || chainConfig.Contract == "" {
+			return nil, fmt.Errorf("evm chain %s requires a rpc and a contract", chainConfig.Name)
+		}
+
+		chainConfig.MaxBlocksStream = util.GetUInt64Option(chainConfig.MaxBlocksStream, ethMaxBlocksToStreamDefault)
+		chainConfig.Confirmations = util.GetUInt64Option(chainConfig.Confirmations, ethConfirmationsDefault)
+		chainConfig.Finality = util.GetStringOption(chainConfig.Finality, streamer.FinalityConfirmations)
+		if !streamer.IsValidFinality(chainConfig.Finality) {
+			return nil, fmt.Errorf("invalid finality \"%s\" of evm chain %s", chainConfig.Finality, chainConfig.Name)
+		}
+		chainConfig.PollingTime = util.GetUIntOption(chainConfig.PollingTime, ethPollingTimeDefault)
+		chainConfig.CatchUpDistance = util.GetUInt64Option(chainConfig.CatchUpDistance, ethCatchUpDistanceDefault)
+
+		evmChains = append(evmChains, chainConfig)
+	}
+
+	return evmChains, nil
+}
+
+// transactionsDbName returns the name of the transactions database of a chain, koinos or an EVM chain
+func transactionsDbName(chainName string) string {
+	return chainName + transactionsDbSuffix
+}
@@ -12,12 +12,14 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
-	"github.com/dgraph-io/badger/v3"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/api"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/reconciliation"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/tracing"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
 	"github.com/mr-tron/base58"
 
@@ -42,16 +44,44 @@ const (
 	ethMaxBlocksToStreamDefault = 500
 	ethConfirmationsDefault     = 15
 	ethPollingTimeDefault       = 3000
+	ethCatchUpDistanceDefault   = 1000
 
 	koinosRPCDefault               = "http://127.0.0.1:8080/"
 	koinosBlockStartDefault        = 0
 	koinosMaxBlocksToStreamDefault = 500
 	koinosPollingTimeDefault       = 3000
+	koinosCatchUpDistanceDefault   = 2000
 
 	emptyDefault = ""
 
-	signaturesExpirationDefault uint = 60 * 60 * 1000 // 60mins
-	apiUrlDefault                    = ":3000"
+	signaturesExpirationDefault    uint = 60 * 60 * 1000 // 60mins
+	apiUrlDefault                       = ":3000"
+	adminApiUrlDefault                  = "127.0.0.1:3100"
+	reconciliationIntervalDefault  uint = 10 * 60 * 1000 // 10mins
+	streamersMaxFailuresDefault    uint = 10
+	catchUpWorkersDefault          uint = 4
+	expirationSweepIntervalDefault uint = 60 * 1000 // 1min
+
+	valueLogGCIntervalDefault     uint = 10 * 60 * 1000 // 10mins
+	retentionCompletedDaysDefault uint = 90
+	retentionIntervalDefault      uint = 24 * 60 * 60 * 1000 // 1 day
+
+	tracingExporterDefault    = tracing.ExporterNone
+	tracingServiceNameDefault = "koinos-bridge-validator"
+
+	requesterIntervalDefault           uint   = 60 * 1000 // 1min
+	requesterMaxAttemptsDefault        uint   = 3
+	requesterRetryDelayDefault         uint   = 30 * 60 * 1000 // 30mins
+	requesterEthGasLimitDefault        uint64 = 200000
+	requesterEthMaxGasPriceDefault     uint64 = 100      // gwei
+	requesterEthDailyLimitDefault      uint64 = 50000000 // gwei, 0.05 ETH
+	requesterKoinosRcLimitDefault      uint64 = 100000000
+	requesterKoinosDailyRcLimitDefault uint64 = 1000000000
+)
+
+const (
+	// exit code of the validator when a streamer failed too many times in a row
+	streamerFailureExitCode = 3
 )
 
 const (
@@ -59,24 +89,35 @@ const (
 	logDir  = "logs"
 )
 
+const (
+	metadataDbName        = "metadata"
+	processedEventsDbName = "processed_events"
+	signingAuditLogDbName = "signing_audit_log"
+	misbehaviorsDbName    = "misbehaviors"
+	pendingDbName         = "pending"
+	historyDbName         = "transaction_history"
+	archiveIndexDbName    = "archive_index"
+	archiveDir            = "archive"
+	transactionsDbSuffix  = "_transactions"
+
+	koinosTransactionsDbName = "koinos" + transactionsDbSuffix
+)
+
 func main() {
+	// admin commands work offline on the databases
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		os.Exit(runCommand(os.Args[1:]))
+	}
+
 	baseDir := flag.StringP(basedirOption, "d", basedirDefault, "the base directory")
 
+	flag.Usage = usage
 	flag.Parse()
 
-	// Expand ~ to the home directory (otherwise you wind up with /home/user/~/.koinos)
-	if strings.HasPrefix(*baseDir, "~") {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			panic(fmt.Sprintf("Could not get home directory: %v", err))
-		}
-		*baseDir = filepath.Join(homeDir, (*baseDir)[1:])
-	}
-
 	var err error
-	*baseDir, err = koinosUtil.InitBaseDir(*baseDir)
+	*baseDir, err = initBaseDir(*baseDir)
 	if err != nil {
-		panic(fmt.Sprintf("Could not initialize baseDir: %s", *baseDir))
+		panic(err.Error())
 	}
 
 	yamlConfig := util.InitYamlConfig(*baseDir)
@@ -88,14 +129,19 @@ func main() {
 	reset := util.GetBoolOption(yamlConfig.Bridge.Reset, resetDefault)
 	signaturesExpiration := util.GetUIntOption(yamlConfig.Bridge.SignaturesExpiration, signaturesExpirationDefault)
 	apiUrl := util.GetStringOption(yamlConfig.Bridge.ApiUrl, apiUrlDefault)
+	adminApiUrl := util.GetStringOption(yamlConfig.Bridge.AdminApiUrl, adminApiUrlDefault)
+	alertWebhook := util.GetStringOption(yamlConfig.Bridge.AlertWebhook, emptyDefault)
+	reconciliationInterval := util.GetUIntOption(yamlConfig.Bridge.ReconciliationInterval, reconciliationIntervalDefault)
+	streamersMaxFailures := util.GetUIntOption(yamlConfig.Bridge.StreamersMaxFailures, streamersMaxFailuresDefault)
+	catchUpWorkers := util.GetUIntOption(yamlConfig.Bridge.CatchUpWorkers, catchUpWorkersDefault)
+	expirationSweepInterval := util.GetUIntOption(yamlConfig.Bridge.ExpirationSweepInterval, expirationSweepIntervalDefault)
 
-	ethRPC := util.GetStringOption(yamlConfig.Bridge.EthereumRpc, ethRPCDefault)
-	ethContract := util.GetStringOption(yamlConfig.Bridge.EthereumContract, emptyDefault)
-	ethBlockStart := util.GetUInt64Option(yamlConfig.Bridge.EthereumBlockStart, ethBlockStartDefault)
-	ethMaxBlocksToStream := util.GetUInt64Option(yamlConfig.Bridge.EthereumMaxBlocksStream, ethMaxBlocksToStreamDefault)
-	ethConfirmations := util.GetUInt64Option(yamlConfig.Bridge.EthereumConfirmations, ethConfirmationsDefault)
 	ethPK := util.GetStringOption(yamlConfig.Bridge.EthereumPK, emptyDefault)
-	ethPollingTime := util.GetUIntOption(yamlConfig.Bridge.EthereumPollingTime, ethPollingTimeDefault)
+
+	evmChainsConfig, err := getEvmChainsConfig(&yamlConfig.Bridge)
+	if err != nil {
+		panic(err.Error())
+	}
 
 	koinosRPC := util.GetStringOption(yamlConfig.Bridge.KoinosRpc, koinosRPCDefault)
 	koinosContract := util.GetStringOption(yamlConfig.Bridge.KoinosContract, emptyDefault)
@@ -103,20 +149,15 @@ func main() {
 	koinosMaxBlocksToStream := util.GetUInt64Option(yamlConfig.Bridge.KoinosMaxBlocksStream, koinosMaxBlocksToStreamDefault)
 	koinosPK := util.GetStringOption(yamlConfig.Bridge.KoinosPK, emptyDefault)
 	koinosPollingTime := util.GetUIntOption(yamlConfig.Bridge.KoinosPollingTime, koinosPollingTimeDefault)
+	koinosCatchUpDistance := util.GetUInt64Option(yamlConfig.Bridge.KoinosCatchUpDistance, koinosCatchUpDistanceDefault)
 
 	validators := make(map[string]util.ValidatorConfig)
-	tokenAddresses := make(map[string]util.TokenConfig)
 
 	for _, validator := range yamlConfig.Bridge.Validators {
 		validators[validator.KoinosAddress] = validator
 		validators[validator.EthereumAddress] = validator
 	}
 
-	for _, tokenAddr := range yamlConfig.Bridge.Tokens {
-		tokenAddresses[tokenAddr.KoinosAddress] = tokenAddr
-		tokenAddresses[tokenAddr.EthereumAddress] = tokenAddr
-	}
-
 	appID := fmt.Sprintf("%s.%s", appName, instanceID)
 
 	// Initialize logger
@@ -126,6 +167,43 @@ func main() {
 		panic(fmt.Sprintf("Invalid log-level: %s. Please choose one of: debug, info, warn, error", logLevel))
 	}
 
+	// tracing
+	tracingConfig := yamlConfig.Bridge.Tracing
+	shutdownTracing, err := tracing.Init(
+		context.Background(),
+		util.GetStringOption(tracingConfig.Exporter, tracingExporterDefault),
+		tracingConfig.Endpoint,
+		tracingConfig.Insecure,
+		util.GetStringOption(tracingConfig.ServiceName, tracingServiceNameDefault),
+		instanceID,
+	)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+	defer flushTracing(shutdownTracing)
+
+	// refuse to start with a configuration the chains or the peers do not match,
+	// or RPCs that cannot be reached, the peers that cannot be reached are only reported
+	preflight := runPreflightChecks(context.Background(), &yamlConfig.Bridge)
+	for _, result := range preflight.Results {
+		switch result.Severity {
+		case preflightError:
+			log.Errorf("preflight %s: %s", result.Check, result.Message)
+		case preflightWarning:
+			log.Warnf("preflight %s: %s", result.Check, result.Message)
+		default:
+			log.Infof("preflight %s: %s", result.Check, result.Message)
+		}
+	}
+
+	err = preflight.Err()
+	if err != nil {
+		util.SendAlert(alertWebhook, "preflight checks failed", err.Error())
+		log.Error(err.Error())
+		panic(err)
+	}
+
 	// keys management
 	koinosPKbytes, err := koinosUtil.DecodeWIF(koinosPK)
 	if err != nil {
@@ -150,41 +228,138 @@ func main() {
 	ethAddress := crypto.PubkeyToAddress(ethPrivateKey.PublicKey).Hex()
 	log.Infof("Node ethAddress %s", ethAddress)
 
-	// metadata store
-	metadataDbDir := path.Join(koinosUtil.GetAppDir((*baseDir), appName), "metadata")
-	koinosUtil.EnsureDir(metadataDbDir)
-	log.Infof("Opening database at %s", metadataDbDir)
+	// databases
+	dbStorage, err := openStorage(*baseDir, yamlConfig.Bridge.Storage)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+	defer dbStorage.close()
 
-	var metadataDbOpts = badger.DefaultOptions(metadataDbDir)
-	metadataDbOpts.Logger = store.KoinosBadgerLogger{}
-	var metadataDbBackend = store.NewBadgerBackend(metadataDbOpts)
-	defer metadataDbBackend.Close()
+	// metadata store
+	metadataDbBackend, err := dbStorage.open(metadataDbName)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
 
 	metadataStore := store.NewMetadataStore(metadataDbBackend)
 
 	// koinos transactions store
-	koinosDbDir := path.Join(koinosUtil.GetAppDir((*baseDir), appName), "koinos_transactions")
-	koinosUtil.EnsureDir(koinosDbDir)
-	log.Infof("Opening database at %s", koinosDbDir)
+	koinosDbBackend, err := dbStorage.open(koinosTransactionsDbName)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	// transitions of the transactions
+	historyDbBackend, err := dbStorage.open(historyDbName)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
 
-	var koinosDbOpts = badger.DefaultOptions(koinosDbDir)
-	koinosDbOpts.Logger = store.KoinosBadgerLogger{}
-	var koinosDbBackend = store.NewBadgerBackend(koinosDbOpts)
-	defer koinosDbBackend.Close()
+	historyStore := store.NewTransactionHistoryStore(historyDbBackend)
 
 	koinosTxStore := store.NewTransactionsStore(koinosDbBackend)
+	koinosTxStore.SetHistory(historyStore, streamer.ChainKoinos)
+
+	// EVM chains transactions stores
+	evmChains := streamer.EvmChains{}
+	evmDbBackends := []store.Backend{}
+
+	for index := range evmChainsConfig {
+		evmDbBackend, err := dbStorage.open(transactionsDbName(evmChainsConfig[index].Name))
+		if err != nil {
+			log.Error(err.Error())
+			panic(err)
+		}
+
+		evmTxStore := store.NewTransactionsStore(evmDbBackend)
+		evmTxStore.SetHistory(historyStore, evmChainsConfig[index].Name)
 
-	// ethereum transactions store
-	ethDbDir := path.Join(koinosUtil.GetAppDir((*baseDir), appName), "ethereum_transactions")
-	koinosUtil.EnsureDir(ethDbDir)
-	log.Infof("Opening database at %s", ethDbDir)
+		evmDbBackends = append(evmDbBackends, evmDbBackend)
+		evmChains = append(evmChains, streamer.NewEvmChain(&evmChainsConfig[index], evmTxStore))
+	}
+
+	// processed events store
+	processedEventsDbBackend, err := dbStorage.open(processedEventsDbName)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
 
-	var ethDbOpts = badger.DefaultOptions(ethDbDir)
-	ethDbOpts.Logger = store.KoinosBadgerLogger{}
-	var ethDbBackend = store.NewBadgerBackend(ethDbOpts)
-	defer ethDbBackend.Close()
+	processedEventsStore := store.NewProcessedEventsStore(processedEventsDbBackend)
 
-	ethTxStore := store.NewTransactionsStore(ethDbBackend)
+	// signing audit log, append-only so it is never reset
+	signingAuditLogDbBackend, err := dbStorage.open(signingAuditLogDbName)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	signingAuditLog, err := store.NewSigningAuditLogStore(signingAuditLogDbBackend)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	// transactions submitted by the peers and not observed yet
+	pendingDbBackend, err := dbStorage.open(pendingDbName)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	pendingTxStore := store.NewPendingTransactionsStore(pendingDbBackend)
+
+	// peers misbehaviors evidences, kept on reset
+	misbehaviorsDbBackend, err := dbStorage.open(misbehaviorsDbName)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	misbehaviorsStore := store.NewMisbehaviorsStore(misbehaviorsDbBackend)
+
+	// completed transactions archived, kept on reset
+	archiveIndexDbBackend, err := dbStorage.open(archiveIndexDbName)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	retentionConfig := yamlConfig.Bridge.Retention
+	archiveStore, err := store.NewArchiveStore(
+		util.GetStringOption(retentionConfig.ArchiveDir, path.Join(koinosUtil.GetAppDir(*baseDir, appName), archiveDir)),
+		archiveIndexDbBackend,
+	)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	storageMaintainer := store.NewStorageMaintainer(dbStorage.badgerBackends, archiveStore)
+
+	// databases of the online backups, the checkpoints come first so that the backup includes the records they cover,
+	// and the processed events before the transactions so that the events missing from the backup are processed again
+	backupDatabases := []store.BackupDatabase{
+		{Name: metadataDbName, Backend: metadataDbBackend},
+		{Name: processedEventsDbName, Backend: processedEventsDbBackend},
+		{Name: koinosTransactionsDbName, Backend: koinosDbBackend},
+	}
+
+	for index, evmDbBackend := range evmDbBackends {
+		backupDatabases = append(backupDatabases, store.BackupDatabase{Name: transactionsDbName(evmChains[index].Name), Backend: evmDbBackend})
+	}
+
+	backupDatabases = append(backupDatabases,
+		store.BackupDatabase{Name: pendingDbName, Backend: pendingDbBackend},
+		store.BackupDatabase{Name: historyDbName, Backend: historyDbBackend},
+		store.BackupDatabase{Name: signingAuditLogDbName, Backend: signingAuditLogDbBackend},
+		store.BackupDatabase{Name: misbehaviorsDbName, Backend: misbehaviorsDbBackend},
+		store.BackupDatabase{Name: archiveIndexDbName, Backend: archiveIndexDbBackend},
+	)
 
 	// Reset backend if requested
 	if reset {
@@ -195,16 +370,36 @@ func main() {
 			panic(fmt.Sprintf("Error resetting metadata database: %s\n", err.Error()))
 		}
 
-		ethDbBackend.Reset()
+		for index, evmDbBackend := range evmDbBackends {
+			err = evmDbBackend.Reset()
+			if err != nil {
+				log.Error(err.Error())
+				panic(fmt.Sprintf("Error resetting %s transactions database: %s\n", evmChains[index].Name, err.Error()))
+			}
+		}
+
+		err = koinosDbBackend.Reset()
 		if err != nil {
 			log.Error(err.Error())
-			panic(fmt.Sprintf("Error resetting ethereum transactions database: %s\n", err.Error()))
+			panic(fmt.Sprintf("Error resetting koinos transactions database: %s\n", err.Error()))
 		}
 
-		koinosDbBackend.Reset()
+		err = processedEventsDbBackend.Reset()
 		if err != nil {
 			log.Error(err.Error())
-			panic(fmt.Sprintf("Error resetting koinos transactions database: %s\n", err.Error()))
+			panic(fmt.Sprintf("Error resetting processed events database: %s\n", err.Error()))
+		}
+
+		err = pendingDbBackend.Reset()
+		if err != nil {
+			log.Error(err.Error())
+			panic(fmt.Sprintf("Error resetting pending transactions database: %s\n", err.Error()))
+		}
+
+		err = historyDbBackend.Reset()
+		if err != nil {
+			log.Error(err.Error())
+			panic(fmt.Sprintf("Error resetting transaction history database: %s\n", err.Error()))
 		}
 	}
 
@@ -216,76 +411,244 @@ func main() {
 		panic(err)
 	}
 
-	if ethBlockStart > 0 {
-		metadata.LastEthereumBlockParsed = ethBlockStart - 1
+	for _, chainConfig := range evmChainsConfig {
+		if chainConfig.BlockStart > 0 {
+			streamer.SetEvmLastBlockParsed(metadata, chainConfig.Name, chainConfig.BlockStart-1)
+		}
+
+		log.Infof("%s last block parsed: %d", chainConfig.Name, streamer.GetEvmLastBlockParsed(metadata, chainConfig.Name))
 	}
 
 	if koinosBlockStart > 0 {
 		metadata.LastKoinosBlockParsed = koinosBlockStart - 1
 	}
 
-	log.Infof("LastEthereumBlockParsed: %d", metadata.LastEthereumBlockParsed)
 	log.Infof("LastKoinosBlockParsed: %d", metadata.LastKoinosBlockParsed)
 
+	// refuse to start if the peers do not use the same configuration,
+	// their signatures would not match ours and the transfers would stall
+	err = util.CheckPeersHandshake(util.NewHandshake(koinosAddress, ethAddress, signaturesExpiration, koinosContract, evmChains[0].ContractAddr.Hex(), evmChains.HandshakeContracts()), validators)
+	if err != nil {
+		util.SendAlert(alertWebhook, "configuration mismatch with peers", err.Error())
+		log.Error(err.Error())
+		panic(err)
+	}
+
 	// blockchains streaming
 	mainCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	var wg sync.WaitGroup
 
-	if ethMaxBlocksToStream > 0 {
-		wg.Add(1)
-		go streamer.StreamEthereumBlocks(
-			&wg,
-			mainCtx,
-			metadataStore,
-			metadata.LastEthereumBlockParsed,
-			ethRPC,
-			ethContract,
-			ethMaxBlocksToStream,
-			koinosPKbytes,
-			koinosAddress,
-			koinosContract,
-			tokenAddresses,
-			ethTxStore,
-			koinosTxStore,
-			signaturesExpiration,
-			validators,
-			ethConfirmations,
-			ethPollingTime,
-		)
+	// the streamers are restarted when they exit unexpectedly
+	supervisor := streamer.NewSupervisor(mainCtx, stop, streamersMaxFailures, alertWebhook)
+
+	for _, evmChain := range evmChains {
+		// the transactions are keyed by transaction id and log index
+		err = streamer.MigrateEthereumTransactionKeys(mainCtx, evmChain, processedEventsStore)
+		if err != nil {
+			log.Errorf("cannot migrate %s transactions: %s", evmChain.Name, err.Error())
+		}
+
+		if evmChain.MaxBlocksToStream > 0 {
+			evmChain := evmChain
+			lastBlockParsed := streamer.GetEvmLastBlockParsed(metadata, evmChain.Name)
+
+			supervisor.Run(&wg, evmChain.Name, func(ctx context.Context) error {
+				var err error
+				lastBlockParsed, err = streamer.StreamEthereumBlocks(
+					ctx,
+					metadataStore,
+					lastBlockParsed,
+					evmChain,
+					koinosPKbytes,
+					koinosAddress,
+					koinosContract,
+					koinosTxStore,
+					processedEventsStore,
+					pendingTxStore,
+					signingAuditLog,
+					signaturesExpiration,
+					validators,
+					catchUpWorkers,
+				)
+				return err
+			})
+		}
 	}
 
 	if koinosMaxBlocksToStream > 0 {
-		wg.Add(1)
-		go streamer.StreamKoinosBlocks(
-			&wg,
-			mainCtx,
+		lastBlockParsed := metadata.LastKoinosBlockParsed
+
+		supervisor.Run(&wg, streamer.ChainKoinos, func(ctx context.Context) error {
+			var err error
+			lastBlockParsed, err = streamer.StreamKoinosBlocks(
+				ctx,
+				metadataStore,
+				lastBlockParsed,
+				koinosRPC,
+				ethPrivateKey,
+				ethAddress,
+				evmChains,
+				koinosMaxBlocksToStream,
+				koinosPKbytes,
+				koinosAddress,
+				koinosContract,
+				koinosTxStore,
+				processedEventsStore,
+				pendingTxStore,
+				signingAuditLog,
+				signaturesExpiration,
+				validators,
+				alertWebhook,
+				koinosPollingTime,
+				koinosCatchUpDistance,
+				catchUpWorkers,
+			)
+			return err
+		})
+	}
+
+	// transactions whose signatures expired
+	sweeper := streamer.NewExpirationSweeper(evmChains, koinosTxStore)
+	wg.Add(1)
+	go sweeper.Run(&wg, mainCtx, expirationSweepInterval)
+
+	// request_new_signatures of the expired transactions
+	requesterConfig := getSignaturesRequesterConfig(&yamlConfig.Bridge)
+	if requesterConfig.Enabled {
+		requester, err := newSignaturesRequester(
+			requesterConfig,
+			evmChains,
+			koinosTxStore,
 			metadataStore,
-			metadata.LastKoinosBlockParsed,
 			koinosRPC,
-			ethPrivateKey,
-			ethAddress,
-			ethContract,
-			koinosMaxBlocksToStream,
-			koinosPKbytes,
-			koinosAddress,
 			koinosContract,
-			tokenAddresses,
-			ethTxStore,
-			koinosTxStore,
 			signaturesExpiration,
-			validators,
-			koinosPollingTime,
+			ethPrivateKey,
+			koinosKey,
+		)
+		if err != nil {
+			log.Error(err.Error())
+			panic(err)
+		}
+
+		wg.Add(1)
+		go requester.Run(&wg, mainCtx, requesterConfig.Interval)
+	}
+
+	// archival of the completed transactions
+	if retentionConfig.Enabled {
+		archiver := streamer.NewArchiver(
+			evmChains,
+			koinosTxStore,
+			historyStore,
+			archiveStore,
+			util.GetUIntOption(retentionConfig.CompletedDays, retentionCompletedDaysDefault),
 		)
+		wg.Add(1)
+		go archiver.Run(&wg, mainCtx, util.GetUIntOption(retentionConfig.Interval, retentionIntervalDefault))
+	}
+
+	// value logs garbage collection, badger only
+	if len(dbStorage.badgerBackends) > 0 {
+		wg.Add(1)
+		go storageMaintainer.Run(&wg, mainCtx, util.GetUIntOption(yamlConfig.Bridge.Storage.ValueLogGCInterval, valueLogGCIntervalDefault))
+	}
+
+	// balances reconciliation
+	reconciler, err := reconciliation.NewReconciler(
+		evmChains,
+		koinosTxStore,
+		koinosRPC,
+		koinosContract,
+		alertWebhook,
+	)
+
+	if err != nil {
+		log.Errorf("cannot start reconciliation: %s", err.Error())
+	} else {
+		wg.Add(1)
+		go reconciler.Run(&wg, mainCtx, reconciliationInterval)
+	}
+
+	// Run admin API server
+	rescanner, err := streamer.NewRescanner(
+		mainCtx,
+		evmChains,
+		ethPrivateKey,
+		ethAddress,
+		koinosRPC,
+		koinosMaxBlocksToStream,
+		koinosPKbytes,
+		koinosAddress,
+		koinosContract,
+		koinosTxStore,
+		processedEventsStore,
+		pendingTxStore,
+		signingAuditLog,
+		signaturesExpiration,
+		validators,
+		alertWebhook,
+	)
+
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	adminApi := api.NewAdminApi(rescanner, supervisor, storageMaintainer, backupDatabases, reconciler)
+	adminMux := http.NewServeMux()
+	adminMux.HandleFunc("/Rescan", adminApi.Rescan)
+	adminMux.HandleFunc("/GetRescan", adminApi.GetRescan)
+	adminMux.HandleFunc("/GetStreamersStatus", adminApi.GetStreamersStatus)
+	adminMux.HandleFunc("/GetDiskUsage", adminApi.GetDiskUsage)
+	adminMux.HandleFunc("/Backup", adminApi.Backup)
+	adminMux.HandleFunc("/Reconcile", adminApi.Reconcile)
+
+	adminHttpServer := &http.Server{
+		Addr:        adminApiUrl,
+		Handler:     adminMux,
+		BaseContext: func(_ net.Listener) context.Context { return mainCtx },
+	}
+
+	runHttpServer(&wg, mainCtx, adminHttpServer)
+
+	// on-demand verification of the transactions submitted by the peers
+	verifier, err := streamer.NewVerifier(
+		mainCtx,
+		evmChains,
+		ethPrivateKey,
+		ethAddress,
+		koinosRPC,
+		koinosPKbytes,
+		koinosAddress,
+		koinosContract,
+		koinosTxStore,
+		processedEventsStore,
+		pendingTxStore,
+		signingAuditLog,
+		signaturesExpiration,
+		validators,
+		alertWebhook,
+	)
+
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
 	}
 
 	// Run API server
-	api := api.NewApi(ethTxStore, koinosTxStore, koinosContract, ethContract, validators, koinosAddress, ethAddress)
+	api := api.NewApi(evmChains, koinosTxStore, pendingTxStore, misbehaviorsStore, historyStore, archiveStore, koinosContract, validators, koinosAddress, ethAddress, reconciler, verifier, signaturesExpiration, alertWebhook)
 	mux := http.NewServeMux()
 	mux.HandleFunc("/GetEthereumTransaction", api.GetEthereumTransaction)
 	mux.HandleFunc("/GetKoinosTransaction", api.GetKoinosTransaction)
 	mux.HandleFunc("/SubmitSignature", api.SubmitSignature)
+	mux.HandleFunc("/GetHandshake", api.GetHandshake)
+	mux.HandleFunc("/reconciliation", api.GetReconciliation)
+	mux.HandleFunc("/GetMisbehaviors", api.GetMisbehaviors)
+	mux.HandleFunc("/GetTransactionHistory", api.GetTransactionHistory)
+	mux.HandleFunc("/GetStalePendingTransactions", api.GetStalePendingTransactions)
 
 	httpServer := &http.Server{
 		Addr:        apiUrl,
@@ -293,10 +656,40 @@ func main() {
 		BaseContext: func(_ net.Listener) context.Context { return mainCtx },
 	}
 
+	runHttpServer(&wg, mainCtx, httpServer)
+
+	wg.Wait()
+
+	// the API servers are stopped, no verification can start anymore
+	verifier.Wait()
+
+	if err := supervisor.Err(); err != nil {
+		// the deferred functions do not run on exit
+		flushTracing(shutdownTracing)
+		dbStorage.close()
+		log.Errorf("validator stopped: %s", err.Error())
+		os.Exit(streamerFailureExitCode)
+	}
+
+	log.Info("graceful stop completed")
+}
+
+// flushTracing exports the spans not exported yet and stops the tracing
+func flushTracing(shutdown func(context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := shutdown(ctx); err != nil {
+		log.Warnf("cannot flush the traces: %s", err.Error())
+	}
+}
+
+// runHttpServer serves httpServer until ctx is done
+func runHttpServer(wg *sync.WaitGroup, ctx context.Context, httpServer *http.Server) {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		log.Infof("starting HTTP server listener at %s", apiUrl)
+		log.Infof("starting HTTP server listener at %s", httpServer.Addr)
 
 		if err := httpServer.ListenAndServe(); err != http.ErrServerClosed {
 			log.Errorf("HTTP server ListenAndServe: %v", err)
@@ -306,13 +699,29 @@ func main() {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		<-mainCtx.Done()
-		log.Info("stopping HTTP server")
+		<-ctx.Done()
+		log.Infof("stopping HTTP server %s", httpServer.Addr)
 		if err := httpServer.Shutdown(context.Background()); err != nil {
 			log.Errorf("Server forced to shutdown: %s", err.Error())
 		}
 	}()
+}
 
-	wg.Wait()
-	log.Info("graceful stop completed")
+// initBaseDir expands ~ to the home directory and initializes the base directory
+func initBaseDir(baseDir string) (string, error) {
+	// Expand ~ to the home directory (otherwise you wind up with /home/user/~/.koinos)
+	if strings.HasPrefix(baseDir, "~") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("Could not get home directory: %v", err)
+		}
+		baseDir = filepath.Join(homeDir, baseDir[1:])
+	}
+
+	baseDir, err := koinosUtil.InitBaseDir(baseDir)
+	if err != nil {
+		return "", fmt.Errorf("Could not initialize baseDir: %s", baseDir)
+	}
+
+	return baseDir, nil
 }
@@ -0,0 +1,442 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	koinosUtil "github.com/koinos/koinos-util-golang"
+	kjsonrpc "github.com/koinos/koinos-util-golang/rpc"
+	"github.com/mr-tron/base58"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/rpc"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+// severities of the preflight checks results, only the errors prevent the validator from starting
+const (
+	preflightOk      = "ok"
+	preflightWarning = "warning"
+	preflightError   = "error"
+
+	// timeout of each request of the preflight checks
+	preflightTimeout = 10 * time.Second
+)
+
+// preflightResult is the result of a preflight check
+type preflightResult struct {
+	Check    string
+	Severity string
+	Message  string
+}
+
+// preflightReport gathers the results of the preflight checks of a configuration
+type preflightReport struct {
+	Results []*preflightResult
+}
+
+func (report *preflightReport) add(check string, severity string, format string, args ...interface{}) {
+	report.Results = append(report.Results, &preflightResult{
+		Check:    check,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (report *preflightReport) ok(check string, format string, args ...interface{}) {
+	report.add(check, preflightOk, format, args...)
+}
+
+func (report *preflightReport) warn(check string, format string, args ...interface{}) {
+	report.add(check, preflightWarning, format, args...)
+}
+
+func (report *preflightReport) fail(check string, format string, args ...interface{}) {
+	report.add(check, preflightError, format, args...)
+}
+
+// Err returns an error listing the failed checks, nil if none failed
+func (report *preflightReport) Err() error {
+	failures := []string{}
+	for _, result := range report.Results {
+		if result.Severity == preflightError {
+			failures = append(failures, result.Check+": "+result.Message)
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%d preflight checks failed: %s", len(failures), strings.Join(failures, "; "))
+}
+
+// runPreflightChecks checks the configuration and that the RPCs, the contracts and the peers it points at match it.
+// A mismatch or an RPC that cannot be reached is an error, a peer that cannot be reached is a warning since it may only be temporarily down.
+func runPreflightChecks(ctx context.Context, bridgeConfig *util.BridgeConfig) *preflightReport {
+	report := &preflightReport{}
+
+	evmChainsConfig, err := getEvmChainsConfig(bridgeConfig)
+	if err != nil {
+		report.fail("evm chains", "%s", err.Error())
+		return report
+	}
+
+	koinosContract := util.GetStringOption(bridgeConfig.KoinosContract, emptyDefault)
+
+	addressesValid := checkAddresses(report, bridgeConfig, koinosContract, evmChainsConfig)
+	checkValidatorSet(report, bridgeConfig)
+
+	// the contracts are only looked up at valid addresses
+	for index := range evmChainsConfig {
+		checkEvmChain(ctx, report, &evmChainsConfig[index], addressesValid)
+	}
+	checkKoinos(ctx, report, bridgeConfig, koinosContract, addressesValid)
+
+	checkPeers(ctx, report, bridgeConfig)
+
+	return report
+}
+
+// checkAddresses checks that all the addresses of the configuration parse and use valid checksums,
+// it returns false if one does not
+func checkAddresses(report *preflightReport, bridgeConfig *util.BridgeConfig, koinosContract string, evmChainsConfig []util.EvmChainConfig) bool {
+	check := "addresses"
+	valid := true
+
+	checkKoinosAddress := func(name string, address string) {
+		if err := util.ValidateKoinosAddress(address); err != nil {
+			report.fail(check, "%s: %s", name, err.Error())
+			valid = false
+		}
+	}
+
+	checkEthereumAddress := func(name string, address string) {
+		if err := util.ValidateEthereumAddress(address); err != nil {
+			report.fail(check, "%s: %s", name, err.Error())
+			valid = false
+		}
+	}
+
+	checkKoinosAddress("koinos-contract", koinosContract)
+
+	for _, chainConfig := range evmChainsConfig {
+		checkEthereumAddress(fmt.Sprintf("contract of evm chain %s", chainConfig.Name), chainConfig.Contract)
+
+		for _, name := range sortedTokenNames(chainConfig.Tokens) {
+			token := chainConfig.Tokens[name]
+			checkKoinosAddress(fmt.Sprintf("koinos-address of token %s of evm chain %s", name, chainConfig.Name), token.KoinosAddress)
+			checkEthereumAddress(fmt.Sprintf("ethereum-address of token %s of evm chain %s", name, chainConfig.Name), token.EthereumAddress)
+		}
+	}
+
+	for _, name := range sortedValidatorNames(bridgeConfig.Validators) {
+		validator := bridgeConfig.Validators[name]
+		checkKoinosAddress(fmt.Sprintf("koinos-address of validator %s", name), validator.KoinosAddress)
+		checkEthereumAddress(fmt.Sprintf("ethereum-address of validator %s", name), validator.EthereumAddress)
+
+		apiUrl, err := url.Parse(validator.ApiUrl)
+		if err != nil || (apiUrl.Scheme != "http" && apiUrl.Scheme != "https") || apiUrl.Host == "" {
+			report.fail(check, "api-url of validator %s: invalid url \"%s\"", name, validator.ApiUrl)
+			valid = false
+		}
+	}
+
+	if valid {
+		report.ok(check, "all the addresses are valid")
+	}
+
+	return valid
+}
+
+// checkValidatorSet checks that the addresses of the keys of the node are the addresses of a validator
+func checkValidatorSet(report *preflightReport, bridgeConfig *util.BridgeConfig) {
+	check := "validator set"
+
+	if len(bridgeConfig.Validators) == 0 {
+		report.fail(check, "no validators configured")
+		return
+	}
+
+	koinosAddress, err := nodeKoinosAddress(bridgeConfig)
+	if err != nil {
+		report.fail(check, "invalid koinos-pk: %s", err.Error())
+	}
+
+	ethAddress := ""
+	ethPrivateKey, err := crypto.HexToECDSA(util.GetStringOption(bridgeConfig.EthereumPK, emptyDefault))
+	if err != nil {
+		report.fail(check, "invalid ethereum-pk: %s", err.Error())
+	} else {
+		ethAddress = crypto.PubkeyToAddress(ethPrivateKey.PublicKey).Hex()
+	}
+
+	if koinosAddress == "" || ethAddress == "" {
+		return
+	}
+
+	// the validators are looked up by both their addresses, each address must identify a single validator
+	names := make(map[string]string)
+	duplicated := false
+	for _, name := range sortedValidatorNames(bridgeConfig.Validators) {
+		validator := bridgeConfig.Validators[name]
+
+		for _, address := range []string{validator.KoinosAddress, validator.EthereumAddress} {
+			if other, found := names[address]; found && other != name {
+				report.fail(check, "address %s is used by validators %s and %s", address, other, name)
+				duplicated = true
+			}
+			names[address] = name
+		}
+	}
+
+	name, found := names[koinosAddress]
+	if !found {
+		report.fail(check, "the address %s of koinos-pk is not in the validators", koinosAddress)
+		return
+	}
+
+	if bridgeConfig.Validators[name].EthereumAddress != ethAddress {
+		report.fail(check, "the address %s of ethereum-pk is not the ethereum-address of validator %s (%s)", ethAddress, name, bridgeConfig.Validators[name].EthereumAddress)
+		return
+	}
+
+	if duplicated {
+		return
+	}
+
+	report.ok(check, "node is validator %s (%s, %s) of %d validators", name, koinosAddress, ethAddress, len(bridgeConfig.Validators))
+}
+
+// checkEvmChain checks that the RPC of the chain serves the configured chain id and that the contracts exist
+func checkEvmChain(ctx context.Context, report *preflightReport, chainConfig *util.EvmChainConfig, addressesValid bool) {
+	check := chainConfig.Name + " rpc"
+
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+
+	ethCl, err := streamer.DialEvmChain(ctx, streamer.NewEvmChain(chainConfig, nil))
+	if err != nil {
+		report.fail(check, "cannot connect to %s: %s", chainConfig.Rpc, err.Error())
+		return
+	}
+	defer ethCl.Close()
+
+	chainId, err := ethCl.ChainID(ctx)
+	if err != nil {
+		report.fail(check, "cannot get the chain id from %s: %s", chainConfig.Rpc, err.Error())
+		return
+	}
+
+	if chainConfig.ChainId == 0 {
+		report.ok(check, "chain id %s (not checked, no chain-id configured)", chainId.String())
+	} else if !chainId.IsUint64() || chainId.Uint64() != chainConfig.ChainId {
+		report.fail(check, "%s serves the chain id %s, chain-id %d is configured", chainConfig.Rpc, chainId.String(), chainConfig.ChainId)
+		return
+	} else {
+		report.ok(check, "chain id %d", chainConfig.ChainId)
+	}
+
+	if !addressesValid {
+		return
+	}
+
+	check = chainConfig.Name + " contracts"
+	contracts := map[string]string{"bridge contract": chainConfig.Contract}
+	for name, token := range chainConfig.Tokens {
+		contracts["token "+name] = token.EthereumAddress
+	}
+
+	missing := false
+	for _, name := range sortedContractNames(contracts) {
+		code, err := ethCl.CodeAt(ctx, common.HexToAddress(contracts[name]), nil)
+		if err != nil {
+			report.warn(check, "cannot get the code of the %s %s: %s", name, contracts[name], err.Error())
+			missing = true
+		} else if len(code) == 0 {
+			report.fail(check, "no contract at the address %s of the %s", contracts[name], name)
+			missing = true
+		}
+	}
+
+	if !missing {
+		report.ok(check, "the bridge contract and %d token contracts exist", len(contracts)-1)
+	}
+}
+
+// checkKoinos checks that the Koinos RPC serves the configured chain id and that the contracts exist
+func checkKoinos(ctx context.Context, report *preflightReport, bridgeConfig *util.BridgeConfig, koinosContract string, addressesValid bool) {
+	check := "koinos rpc"
+
+	ctx, cancel := context.WithTimeout(ctx, preflightTimeout)
+	defer cancel()
+
+	koinosRPC := util.GetStringOption(bridgeConfig.KoinosRpc, koinosRPCDefault)
+	rpcClient := rpc.NewJsonRPC(kjsonrpc.NewKoinosRPCClient(koinosRPC))
+
+	chainIdResponse, err := rpcClient.GetChainId(ctx)
+	if err != nil {
+		report.fail(check, "cannot get the chain id from %s: %s", koinosRPC, err.Error())
+		return
+	}
+	chainId := base64.URLEncoding.EncodeToString(chainIdResponse.ChainId)
+
+	if bridgeConfig.KoinosChainId == "" {
+		report.ok(check, "chain id %s (not checked, no koinos-chain-id configured)", chainId)
+	} else if !bytes.Equal(decodeKoinosChainId(bridgeConfig.KoinosChainId), chainIdResponse.ChainId) {
+		report.fail(check, "%s serves the chain id %s, koinos-chain-id %s is configured", koinosRPC, chainId, bridgeConfig.KoinosChainId)
+		return
+	} else {
+		report.ok(check, "chain id %s", chainId)
+	}
+
+	if !addressesValid {
+		return
+	}
+
+	// the tokens are the same on all the EVM chains
+	check = "koinos contracts"
+	contracts := map[string]string{"bridge contract": koinosContract}
+	evmChainsConfig, _ := getEvmChainsConfig(bridgeConfig)
+	for _, chainConfig := range evmChainsConfig {
+		for name, token := range chainConfig.Tokens {
+			contracts["token "+name] = token.KoinosAddress
+		}
+	}
+
+	// the bridge contract is uploaded with its metadata, a token contract without metadata may exist
+	// so that its absence is only a warning
+	missing := false
+	for _, name := range sortedContractNames(contracts) {
+		contractId, _ := base58.Decode(contracts[name])
+		meta, err := rpcClient.GetContractMeta(ctx, contractId)
+		if err != nil {
+			report.warn(check, "cannot get the metadata of the %s %s: %s", name, contracts[name], err.Error())
+			missing = true
+		} else if meta.Meta == nil || meta.Meta.Abi == "" {
+			if name == "bridge contract" {
+				report.fail(check, "no contract metadata at the address %s of the %s, the contract is not deployed", contracts[name], name)
+			} else {
+				report.warn(check, "no contract metadata at the address %s of the %s, check that the contract is deployed", contracts[name], name)
+			}
+			missing = true
+		}
+	}
+
+	if !missing {
+		report.ok(check, "the bridge contract and %d token contracts exist", len(contracts)-1)
+	}
+}
+
+// decodeKoinosChainId decodes a base64 chain id, URL or standard encoded, nil if it is invalid
+func decodeKoinosChainId(chainId string) []byte {
+	for _, encoding := range []*base64.Encoding{base64.URLEncoding, base64.StdEncoding, base64.RawURLEncoding, base64.RawStdEncoding} {
+		decoded, err := encoding.DecodeString(chainId)
+		if err == nil {
+			return decoded
+		}
+	}
+
+	return nil
+}
+
+// checkPeers checks that the api-url of every peer is reachable
+func checkPeers(ctx context.Context, report *preflightReport, bridgeConfig *util.BridgeConfig) {
+	client := http.Client{
+		Timeout: preflightTimeout,
+	}
+
+	// an invalid koinos-pk is reported by the validator set check
+	koinosAddress, _ := nodeKoinosAddress(bridgeConfig)
+
+	processedApiUrls := make(map[string]bool)
+
+	for _, name := range sortedValidatorNames(bridgeConfig.Validators) {
+		validator := bridgeConfig.Validators[name]
+
+		// don't check yourself
+		if validator.KoinosAddress == koinosAddress || processedApiUrls[validator.ApiUrl] {
+			continue
+		}
+		processedApiUrls[validator.ApiUrl] = true
+
+		check := "peer " + name
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, validator.ApiUrl+"/GetHandshake", nil)
+		if err != nil {
+			report.fail(check, "invalid api-url %s: %s", validator.ApiUrl, err.Error())
+			continue
+		}
+
+		res, err := client.Do(req)
+		if err != nil {
+			report.warn(check, "%s is not reachable: %s", validator.ApiUrl, err.Error())
+			continue
+		}
+		res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			report.warn(check, "%s returned status code %d", validator.ApiUrl, res.StatusCode)
+			continue
+		}
+
+		report.ok(check, "%s is reachable", validator.ApiUrl)
+	}
+}
+
+// nodeKoinosAddress returns the Koinos address of koinos-pk
+func nodeKoinosAddress(bridgeConfig *util.BridgeConfig) (string, error) {
+	koinosPKbytes, err := koinosUtil.DecodeWIF(util.GetStringOption(bridgeConfig.KoinosPK, emptyDefault))
+	if err != nil {
+		return "", err
+	}
+
+	koinosKey, err := koinosUtil.NewKoinosKeysFromBytes(koinosPKbytes)
+	if err != nil {
+		return "", err
+	}
+
+	return base58.Encode(koinosKey.AddressBytes()), nil
+}
+
+// sortedTokenNames returns the names of the tokens in order, so that the checks are reported in a stable order
+func sortedTokenNames(tokens map[string]util.TokenConfig) []string {
+	names := []string{}
+	for name := range tokens {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// sortedValidatorNames returns the names of the validators in order
+func sortedValidatorNames(validators map[string]util.ValidatorConfig) []string {
+	names := []string{}
+	for name := range validators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// sortedContractNames returns the names of the contracts to check in order
+func sortedContractNames(contracts map[string]string) []string {
+	names := []string{}
+	for name := range contracts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
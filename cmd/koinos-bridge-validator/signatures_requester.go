@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	koinosUtil "github.com/koinos/koinos-util-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+// getSignaturesRequesterConfig returns the auto-request-new-signatures config with its defaults
+func getSignaturesRequesterConfig(bridgeConfig *util.BridgeConfig) util.SignaturesRequesterConfig {
+	config := bridgeConfig.AutoRequestNewSignatures
+
+	return util.SignaturesRequesterConfig{
+		Enabled:             config.Enabled,
+		Interval:            util.GetUIntOption(config.Interval, requesterIntervalDefault),
+		MaxAttempts:         util.GetUIntOption(config.MaxAttempts, requesterMaxAttemptsDefault),
+		RetryDelay:          util.GetUIntOption(config.RetryDelay, requesterRetryDelayDefault),
+		EthereumPK:          util.GetStringOption(config.EthereumPK, emptyDefault),
+		KoinosPK:            util.GetStringOption(config.KoinosPK, emptyDefault),
+		EthereumGasLimit:    util.GetUInt64Option(config.EthereumGasLimit, requesterEthGasLimitDefault),
+		EthereumMaxGasPrice: util.GetUInt64Option(config.EthereumMaxGasPrice, requesterEthMaxGasPriceDefault),
+		EthereumDailyLimit:  util.GetUInt64Option(config.EthereumDailyLimit, requesterEthDailyLimitDefault),
+		KoinosRcLimit:       util.GetUInt64Option(config.KoinosRcLimit, requesterKoinosRcLimitDefault),
+		KoinosDailyRcLimit:  util.GetUInt64Option(config.KoinosDailyRcLimit, requesterKoinosDailyRcLimitDefault),
+	}
+}
+
+// newSignaturesRequester creates the SignaturesRequester of the config, its keys must differ from the signing keys
+func newSignaturesRequester(
+	config util.SignaturesRequesterConfig,
+	evmChains streamer.EvmChains,
+	koinosTxStore *store.TransactionsStore,
+	metadataStore *store.MetadataStore,
+	koinosRPC string,
+	koinosContract string,
+	signaturesExpiration uint,
+	ethSigningKey *ecdsa.PrivateKey,
+	koinosSigningKey *koinosUtil.KoinosKey,
+) (*streamer.SignaturesRequester, error) {
+	var ethPrivateKey *ecdsa.PrivateKey
+	var koinosKey *koinosUtil.KoinosKey
+
+	if config.EthereumPK != "" {
+		var err error
+		ethPrivateKey, err = crypto.HexToECDSA(config.EthereumPK)
+		if err != nil {
+			return nil, err
+		}
+
+		if ethPrivateKey.Equal(ethSigningKey) {
+			return nil, fmt.Errorf("the auto-request-new-signatures ethereum-pk must differ from the signing key")
+		}
+	}
+
+	if config.KoinosPK != "" {
+		koinosPKbytes, err := koinosUtil.DecodeWIF(config.KoinosPK)
+		if err != nil {
+			return nil, err
+		}
+
+		koinosKey, err = koinosUtil.NewKoinosKeysFromBytes(koinosPKbytes)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(koinosKey.AddressBytes(), koinosSigningKey.AddressBytes()) {
+			return nil, fmt.Errorf("the auto-request-new-signatures koinos-pk must differ from the signing key")
+		}
+	}
+
+	if ethPrivateKey == nil && koinosKey == nil {
+		return nil, fmt.Errorf("auto-request-new-signatures requires an ethereum-pk or a koinos-pk")
+	}
+
+	return streamer.NewSignaturesRequester(
+		evmChains,
+		koinosTxStore,
+		metadataStore,
+		koinosRPC,
+		koinosContract,
+		signaturesExpiration,
+		config,
+		ethPrivateKey,
+		koinosKey,
+	)
+}
@@ -0,0 +1,347 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	log "github.com/koinos/koinos-log-golang"
+	koinosUtil "github.com/koinos/koinos-util-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+const (
+	storageDriverBadger   = "badger"
+	storageDriverSQLite   = "sqlite"
+	storageDriverPostgres = "postgres"
+
+	sqliteFileName = "bridge.db"
+)
+
+// storage opens the validator databases with the configured storage driver
+type storage struct {
+	baseDir        string
+	driver         string
+	sqlDatabase    *store.SQLDatabase
+	badgerBackends []*store.BadgerBackend
+}
+
+func openStorage(baseDir string, storageConfig util.StorageConfig) (*storage, error) {
+	driver := util.GetStringOption(storageConfig.Driver, storageDriverBadger)
+
+	s := &storage{
+		baseDir: baseDir,
+		driver:  driver,
+	}
+
+	switch driver {
+	case storageDriverBadger:
+		return s, nil
+	case storageDriverSQLite:
+		appDir := koinosUtil.GetAppDir(baseDir, appName)
+		koinosUtil.EnsureDir(appDir)
+		dsn := util.GetStringOption(storageConfig.Dsn, path.Join(appDir, sqliteFileName))
+		log.Infof("Opening SQLite database at %s", dsn)
+
+		database, err := store.NewSQLDatabase(store.SQLiteDriver, dsn)
+		if err != nil {
+			return nil, err
+		}
+		s.sqlDatabase = database
+	case storageDriverPostgres:
+		if storageConfig.Dsn == "" {
+			return nil, fmt.Errorf("storage dsn is required for the %s driver", driver)
+		}
+		log.Info("Opening PostgreSQL database")
+
+		database, err := store.NewSQLDatabase(store.PostgresDriver, storageConfig.Dsn)
+		if err != nil {
+			return nil, err
+		}
+		s.sqlDatabase = database
+	default:
+		return nil, fmt.Errorf("invalid storage driver %s, expected %s, %s or %s", driver, storageDriverBadger, storageDriverSQLite, storageDriverPostgres)
+	}
+
+	return s, nil
+}
+
+// open opens the database with the given name
+func (s *storage) open(name string) (store.Backend, error) {
+	if s.sqlDatabase != nil {
+		if strings.HasSuffix(name, transactionsDbSuffix) {
+			return store.NewSQLTransactionsBackend(s.sqlDatabase, name), nil
+		}
+
+		return store.NewSQLBackend(s.sqlDatabase, name), nil
+	}
+
+	backend, err := openBadgerBackend(s.baseDir, name)
+	if err != nil {
+		return nil, err
+	}
+	s.badgerBackends = append(s.badgerBackends, backend)
+
+	return backend, nil
+}
+
+// compact compacts the opened databases
+func (s *storage) compact() error {
+	if s.sqlDatabase != nil {
+		fmt.Printf("compacting %s database\n", s.driver)
+		return s.sqlDatabase.Compact()
+	}
+
+	for _, backend := range s.badgerBackends {
+		fmt.Printf("compacting %s\n", backend.DB.Opts().Dir)
+		err := backend.Compact()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// close closes the opened databases
+func (s *storage) close() {
+	if s.sqlDatabase != nil {
+		s.sqlDatabase.Close()
+	}
+
+	for _, backend := range s.badgerBackends {
+		backend.Close()
+	}
+}
+
+// openBadgerBackend opens the badger database with the given name in the app directory
+func openBadgerBackend(baseDir string, name string) (*store.BadgerBackend, error) {
+	dbDir := path.Join(koinosUtil.GetAppDir(baseDir, appName), name)
+	koinosUtil.EnsureDir(dbDir)
+	log.Infof("Opening database at %s", dbDir)
+
+	var dbOpts = badger.DefaultOptions(dbDir)
+	dbOpts.Logger = store.KoinosBadgerLogger{}
+
+	return store.NewBadgerBackend(dbOpts)
+}
+
+const (
+	// prefixes of the databases restored from a backup and of the databases they replace
+	restoredDbPrefix = "restored-"
+	replacedDbPrefix = "replaced-"
+)
+
+// backupRestorer restores a backup into fresh databases of the storage, then swaps them with the databases
+type backupRestorer struct {
+	storage        *storage
+	dbNames        map[string]bool
+	badgerBackends map[string]*store.BadgerBackend
+	adminApiUrl    string
+}
+
+// newBackupRestorer returns a restorer of the databases with the given names,
+// the admin API of the validator must be unreachable to restore a SQL storage
+func newBackupRestorer(s *storage, dbNames []string, adminApiUrl string) *backupRestorer {
+	restorer := &backupRestorer{
+		storage:        s,
+		dbNames:        make(map[string]bool),
+		badgerBackends: make(map[string]*store.BadgerBackend),
+		adminApiUrl:    adminApiUrl,
+	}
+	for _, name := range dbNames {
+		restorer.dbNames[name] = true
+	}
+
+	return restorer
+}
+
+// Create returns a fresh database to restore the database with the given name into
+func (restorer *backupRestorer) Create(name string) (store.Backend, error) {
+	if !restorer.dbNames[name] {
+		return nil, fmt.Errorf("the backup contains the database %s which is not configured", name)
+	}
+
+	if restorer.storage.sqlDatabase != nil {
+		backend, err := restorer.storage.open(restoredDbPrefix + name)
+		if err != nil {
+			return nil, err
+		}
+
+		// the leftovers of a failed restore
+		return backend, backend.Reset()
+	}
+
+	err := os.RemoveAll(restorer.badgerDir(restoredDbPrefix + name))
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := openBadgerBackend(restorer.storage.baseDir, restoredDbPrefix+name)
+	if err != nil {
+		return nil, err
+	}
+	restorer.badgerBackends[name] = backend
+
+	return backend, nil
+}
+
+// CheckStopped returns an error if the validator may be using the databases with the given names: with badger
+// a database cannot be opened while the validator holds its directory lock, with SQL the admin API must be unreachable
+func (restorer *backupRestorer) CheckStopped(names []string) error {
+	if restorer.storage.sqlDatabase != nil {
+		client := &http.Client{Timeout: 2 * time.Second}
+		res, err := client.Get(restorer.adminApiUrl + "/GetStreamersStatus")
+		if err == nil {
+			res.Body.Close()
+			return fmt.Errorf("the validator is running (admin API %s reachable), stop it before restoring", restorer.adminApiUrl)
+		}
+
+		return nil
+	}
+
+	for _, name := range names {
+		_, err := os.Stat(restorer.badgerDir(name))
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		backend, err := openBadgerBackend(restorer.storage.baseDir, name)
+		if err != nil {
+			return fmt.Errorf("the database %s is in use, stop the validator before restoring: %v", name, err)
+		}
+		backend.Close()
+	}
+
+	return nil
+}
+
+// Swap replaces the databases with the restored ones, the replaced databases are only removed once all are swapped
+func (restorer *backupRestorer) Swap(names []string) error {
+	err := restorer.CheckStopped(names)
+	if err != nil {
+		return err
+	}
+
+	if restorer.storage.sqlDatabase != nil {
+		replaced := make(map[string]string)
+		for _, name := range names {
+			replaced[name] = restoredDbPrefix + name
+		}
+
+		return restorer.storage.sqlDatabase.ReplaceStores(replaced)
+	}
+
+	restorer.closeBadgerBackends()
+
+	swapped := []string{}
+	for _, name := range names {
+		err := restorer.swapBadgerDir(name)
+		if err != nil {
+			// put back the databases already swapped
+			for index := len(swapped) - 1; index >= 0; index-- {
+				restoreErr := restorer.unswapBadgerDir(swapped[index])
+				if restoreErr != nil {
+					return fmt.Errorf("%v, cannot put back the database %s: %v", err, swapped[index], restoreErr)
+				}
+			}
+
+			return err
+		}
+		swapped = append(swapped, name)
+	}
+
+	for _, name := range names {
+		err := os.RemoveAll(restorer.badgerDir(replacedDbPrefix + name))
+		if err != nil {
+			log.Warnf("cannot remove the replaced database %s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// Discard removes the restored databases
+func (restorer *backupRestorer) Discard(names []string) {
+	if restorer.storage.sqlDatabase != nil {
+		for _, name := range names {
+			backend, err := restorer.storage.open(restoredDbPrefix + name)
+			if err == nil {
+				err = backend.Reset()
+			}
+			if err != nil {
+				log.Warnf("cannot remove the restored database %s: %v", name, err)
+			}
+		}
+
+		return
+	}
+
+	restorer.closeBadgerBackends()
+
+	for _, name := range names {
+		err := os.RemoveAll(restorer.badgerDir(restoredDbPrefix + name))
+		if err != nil {
+			log.Warnf("cannot remove the restored database %s: %v", name, err)
+		}
+	}
+}
+
+func (restorer *backupRestorer) closeBadgerBackends() {
+	for name, backend := range restorer.badgerBackends {
+		backend.Close()
+		delete(restorer.badgerBackends, name)
+	}
+}
+
+func (restorer *backupRestorer) badgerDir(name string) string {
+	return path.Join(koinosUtil.GetAppDir(restorer.storage.baseDir, appName), name)
+}
+
+// swapBadgerDir moves the database aside and moves its restored database in its place
+func (restorer *backupRestorer) swapBadgerDir(name string) error {
+	dbDir := restorer.badgerDir(name)
+	replacedDir := restorer.badgerDir(replacedDbPrefix + name)
+
+	err := os.RemoveAll(replacedDir)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(dbDir, replacedDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	err = os.Rename(restorer.badgerDir(restoredDbPrefix+name), dbDir)
+	if err != nil {
+		// put back the database
+		os.Rename(replacedDir, dbDir)
+		return err
+	}
+
+	return nil
+}
+
+// unswapBadgerDir moves back the database replaced by swapBadgerDir
+func (restorer *backupRestorer) unswapBadgerDir(name string) error {
+	dbDir := restorer.badgerDir(name)
+
+	err := os.Rename(dbDir, restorer.badgerDir(restoredDbPrefix+name))
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(restorer.badgerDir(replacedDbPrefix+name), dbDir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
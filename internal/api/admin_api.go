@@ -0,0 +1,196 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	log "github.com/koinos/koinos-log-golang"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/reconciliation"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+)
+
+// AdminApi serves the operator endpoints, it must not be exposed publicly
+type AdminApi struct {
+	rescanner         *streamer.Rescanner
+	supervisor        *streamer.Supervisor
+	storageMaintainer *store.StorageMaintainer
+	backupDatabases   []store.BackupDatabase
+	reconciler        *reconciliation.Reconciler
+}
+
+func NewAdminApi(rescanner *streamer.Rescanner, supervisor *streamer.Supervisor, storageMaintainer *store.StorageMaintainer, backupDatabases []store.BackupDatabase, reconciler *reconciliation.Reconciler) *AdminApi {
+	return &AdminApi{
+		reconciler:        reconciler,
+		rescanner:         rescanner,
+		supervisor:        supervisor,
+		storageMaintainer: storageMaintainer,
+		backupDatabases:   backupDatabases,
+	}
+}
+
+func writeJson(w http.ResponseWriter, value interface{}) {
+	jsonBytes, err := json.Marshal(value)
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("unknown error"))
+		log.Error(err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonBytes)
+}
+
+// Reconcile runs a reconciliation of the balances and returns its report, the public API serves the last report
+func (api *AdminApi) Reconcile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	if api.reconciler == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("reconciliation is not available"))
+		return
+	}
+
+	writeJson(w, api.reconciler.Reconcile(r.Context()))
+}
+
+func (api *AdminApi) Rescan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	chainParams := r.URL.Query()["Chain"]
+	fromBlockParams := r.URL.Query()["FromBlock"]
+	toBlockParams := r.URL.Query()["ToBlock"]
+
+	if len(chainParams) <= 0 || len(fromBlockParams) <= 0 || len(toBlockParams) <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Missing Chain, FromBlock or ToBlock param"))
+		return
+	}
+
+	fromBlock, err := strconv.ParseUint(fromBlockParams[0], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid FromBlock"))
+		return
+	}
+
+	toBlock, err := strconv.ParseUint(toBlockParams[0], 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Invalid ToBlock"))
+		return
+	}
+
+	job, err := api.rescanner.Start(chainParams[0], fromBlock, toBlock)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	writeJson(w, job)
+}
+
+func (api *AdminApi) GetRescan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	idParams := r.URL.Query()["Id"]
+
+	if len(idParams) <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Missing Id param"))
+		return
+	}
+
+	job := api.rescanner.Get(idParams[0])
+
+	if job == nil {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("rescan does not exist"))
+		return
+	}
+
+	writeJson(w, job)
+}
+
+func (api *AdminApi) GetStreamersStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	writeJson(w, api.supervisor.Status())
+}
+
+func (api *AdminApi) GetDiskUsage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	usage, err := api.storageMaintainer.Usage()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("unknown error"))
+		log.Error(err.Error())
+		return
+	}
+
+	m := protojson.MarshalOptions{
+		EmitUnpopulated: true,
+	}
+
+	jsonBytes, err := m.Marshal(usage)
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("unknown error"))
+		log.Error(err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonBytes)
+}
+
+// Backup streams an online backup of the databases, a backup interrupted by an error is truncated
+// and fails its verification
+func (api *AdminApi) Backup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.WriteHeader(http.StatusOK)
+
+	trailer, err := store.Backup(w, api.backupDatabases)
+	if err != nil {
+		log.Errorf("backup failed: %s", err.Error())
+		return
+	}
+
+	log.Infof("backup of %d records done, checksum %s", trailer.Records, trailer.Checksum)
+}
@@ -14,26 +14,35 @@ import (
 
 	"net/http"
 
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/reconciliation"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/tracing"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
 	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 )
 
 type Api struct {
-	ethTxStore            *store.TransactionsStore
+	evmChains             streamer.EvmChains
 	koinosTxStore         *store.TransactionsStore
+	pendingTxStore        *store.PendingTransactionsStore
+	misbehaviorsStore     *store.MisbehaviorsStore
+	historyStore          *store.TransactionHistoryStore
+	archiveStore          *store.ArchiveStore
 	koinosContractAddress []byte
-	ethContractAddress    common.Address
 	validators            map[string]util.ValidatorConfig
 	koinosAddress         string
 	ethAddress            string
+	reconciler            *reconciliation.Reconciler
+	verifier              *streamer.Verifier
+	signaturesExpiration  uint
+	alertWebhook          string
 }
 
-func NewApi(ethTxStore *store.TransactionsStore, koinosTxStore *store.TransactionsStore, koinosContractStr string, ethContractStr string, validators map[string]util.ValidatorConfig, koinosAddress string, ethAddress string) *Api {
-	ethContractAddress := common.HexToAddress(ethContractStr)
-
+func NewApi(evmChains streamer.EvmChains, koinosTxStore *store.TransactionsStore, pendingTxStore *store.PendingTransactionsStore, misbehaviorsStore *store.MisbehaviorsStore, historyStore *store.TransactionHistoryStore, archiveStore *store.ArchiveStore, koinosContractStr string, validators map[string]util.ValidatorConfig, koinosAddress string, ethAddress string, reconciler *reconciliation.Reconciler, verifier *streamer.Verifier, signaturesExpiration uint, alertWebhook string) *Api {
 	koinosContractAddress, err := base58.Decode(koinosContractStr)
 	if err != nil {
 		log.Error(err.Error())
@@ -41,13 +50,20 @@ func NewApi(ethTxStore *store.TransactionsStore, koinosTxStore *store.Transactio
 	}
 
 	return &Api{
-		ethTxStore:            ethTxStore,
+		evmChains:             evmChains,
 		koinosTxStore:         koinosTxStore,
+		pendingTxStore:        pendingTxStore,
+		misbehaviorsStore:     misbehaviorsStore,
+		historyStore:          historyStore,
+		archiveStore:          archiveStore,
 		koinosContractAddress: koinosContractAddress,
-		ethContractAddress:    ethContractAddress,
 		validators:            validators,
 		koinosAddress:         koinosAddress,
 		ethAddress:            ethAddress,
+		reconciler:            reconciler,
+		verifier:              verifier,
+		signaturesExpiration:  signaturesExpiration,
+		alertWebhook:          alertWebhook,
 	}
 }
 
@@ -67,9 +83,77 @@ func (api *Api) GetEthereumTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	transaction, _ := api.ethTxStore.Get(transactionIdParams[0])
+	// the chain is optional, the transaction is looked for in all the EVM chains otherwise
+	var chain *streamer.EvmChain
+	chainParams := r.URL.Query()["Chain"]
+
+	if len(chainParams) > 0 {
+		chain = api.evmChains.Get(chainParams[0])
+		if chain == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Invalid Chain"))
+			return
+		}
+	}
+
+	// the log index is optional, all the bridge events of the transaction are returned otherwise
+	var response proto.Message
+	logIndexParams := r.URL.Query()["LogIndex"]
+
+	if len(logIndexParams) > 0 {
+		logIndex, err := strconv.ParseUint(logIndexParams[0], 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Invalid LogIndex param"))
+			return
+		}
+
+		txKey := streamer.EthereumTransactionKey(transactionIdParams[0], logIndex)
+
+		var transaction *bridge_pb.Transaction
+		if chain != nil {
+			transaction, _ = chain.TxStore.Get(txKey)
+		} else {
+			_, transaction, _ = api.evmChains.GetTransaction(txKey)
+		}
+
+		if transaction == nil {
+			transaction = api.getUnconfirmedTransaction(chain, txKey)
+		}
+
+		if transaction == nil {
+			if archivedTx := api.getArchivedTransaction(api.archiveChains(chain), txKey); archivedTx != nil {
+				transaction = archivedTx.Transaction
+			}
+		}
+
+		if transaction != nil {
+			streamer.SetRequestNewSignaturesTime(api.signaturesExpiration, transaction)
+			response = transaction
+		}
+	} else {
+		var transactions []*bridge_pb.Transaction
+		if chain != nil {
+			_, transactions, _ = streamer.GetEthereumTransactions(chain.TxStore, transactionIdParams[0])
+		} else {
+			_, _, transactions, _ = api.evmChains.GetTransactions(transactionIdParams[0])
+		}
+
+		if len(transactions) == 0 {
+			transactions = api.getUnconfirmedTransactions(chain, transactionIdParams[0])
+		}
+
+		if len(transactions) == 0 {
+			transactions = archivedTransactions(api.getArchivedTransactions(api.archiveChains(chain), transactionIdParams[0]))
+		}
 
-	if transaction == nil {
+		if len(transactions) > 0 {
+			streamer.SetRequestNewSignaturesTime(api.signaturesExpiration, transactions...)
+			response = &bridge_pb.Transactions{Transactions: transactions}
+		}
+	}
+
+	if response == nil {
 		w.WriteHeader(http.StatusNotFound)
 		w.Write([]byte("transaction does not exist"))
 		return
@@ -79,7 +163,7 @@ func (api *Api) GetEthereumTransaction(w http.ResponseWriter, r *http.Request) {
 		EmitUnpopulated: true,
 	}
 
-	jsonBytes, err := m.Marshal(transaction)
+	jsonBytes, err := m.Marshal(response)
 
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -93,6 +177,38 @@ func (api *Api) GetEthereumTransaction(w http.ResponseWriter, r *http.Request) {
 	w.Write(jsonBytes)
 }
 
+// getUnconfirmedTransaction returns the bridge event txKey not final yet of chain, or of any chain when nil
+func (api *Api) getUnconfirmedTransaction(chain *streamer.EvmChain, txKey string) *bridge_pb.Transaction {
+	for _, evmChain := range api.evmChains {
+		if chain != nil && evmChain != chain {
+			continue
+		}
+
+		transaction, _ := evmChain.UnconfirmedTxStore.Get(txKey)
+		if transaction != nil {
+			return transaction
+		}
+	}
+
+	return nil
+}
+
+// getUnconfirmedTransactions returns the bridge events not final yet of the transaction txId of chain, or of any chain when nil
+func (api *Api) getUnconfirmedTransactions(chain *streamer.EvmChain, txId string) []*bridge_pb.Transaction {
+	for _, evmChain := range api.evmChains {
+		if chain != nil && evmChain != chain {
+			continue
+		}
+
+		_, transactions, _ := streamer.GetEthereumTransactions(evmChain.UnconfirmedTxStore, txId)
+		if len(transactions) > 0 {
+			return transactions
+		}
+	}
+
+	return nil
+}
+
 func (api *Api) GetKoinosTransaction(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*") // cors
 	if r.Method != "GET" {
@@ -110,40 +226,44 @@ func (api *Api) GetKoinosTransaction(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// if no other bridge unrelated operations are present in the transaction
-	// the opId is 1 or 3
-	opId := "1"
+	// the op id is optional, all the bridge operations of the transaction are returned otherwise
+	var response proto.Message
 
 	if len(opIdParams) > 0 {
-		opId = opIdParams[0]
-	}
-
-	txKey := transactionIdParams[0] + "-" + opId
+		transaction, _ := api.koinosTxStore.Get(transactionIdParams[0] + "-" + opIdParams[0])
+		if transaction == nil {
+			if archivedTx := api.getArchivedTransaction([]string{streamer.ChainKoinos}, transactionIdParams[0]+"-"+opIdParams[0]); archivedTx != nil {
+				transaction = archivedTx.Transaction
+			}
+		}
 
-	transaction, _ := api.koinosTxStore.Get(txKey)
+		if transaction != nil {
+			streamer.SetRequestNewSignaturesTime(api.signaturesExpiration, transaction)
+			response = transaction
+		}
+	} else {
+		_, transactions, _ := streamer.GetKoinosTransactions(api.koinosTxStore, transactionIdParams[0])
+		if len(transactions) == 0 {
+			transactions = archivedTransactions(api.getArchivedTransactions([]string{streamer.ChainKoinos}, transactionIdParams[0]))
+		}
 
-	if transaction == nil {
-		if opId == "1" {
-			opId = "3"
-			txKey = transactionIdParams[0] + "-" + opId
-			transaction, _ = api.koinosTxStore.Get(txKey)
-			if transaction == nil {
-				w.WriteHeader(http.StatusNotFound)
-				w.Write([]byte("transaction does not exist"))
-				return
-			}
-		} else {
-			w.WriteHeader(http.StatusNotFound)
-			w.Write([]byte("transaction does not exist"))
-			return
+		if len(transactions) > 0 {
+			streamer.SetRequestNewSignaturesTime(api.signaturesExpiration, transactions...)
+			response = &bridge_pb.Transactions{Transactions: transactions}
 		}
 	}
 
+	if response == nil {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("transaction does not exist"))
+		return
+	}
+
 	m := protojson.MarshalOptions{
 		EmitUnpopulated: true,
 	}
 
-	jsonBytes, err := m.Marshal(transaction)
+	jsonBytes, err := m.Marshal(response)
 
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -157,7 +277,89 @@ func (api *Api) GetKoinosTransaction(w http.ResponseWriter, r *http.Request) {
 	w.Write(jsonBytes)
 }
 
+func (api *Api) GetReconciliation(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*") // cors
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	if api.reconciler == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("reconciliation is not available"))
+		return
+	}
+
+	// the public API only serves the last report, the reconciliations are run by the reconciler
+	// or on demand from the admin API
+	report := api.reconciler.LastReport()
+	if report == nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("no reconciliation report yet"))
+		return
+	}
+
+	writeJson(w, report)
+}
+
+func (api *Api) GetHandshake(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*") // cors
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	writeJson(w, util.NewHandshake(api.koinosAddress, api.ethAddress, api.signaturesExpiration, base58.Encode(api.koinosContractAddress), api.evmChains[0].ContractAddr.Hex(), api.evmChains.HandshakeContracts()))
+}
+
+// expirationMismatch reports a transaction received from a peer with an expiration different from the local one,
+// which means that the validators do not use the same signatures-expiration
+func (api *Api) expirationMismatch(w http.ResponseWriter, txId string, localExpiration uint64, receivedExpiration uint64) {
+	errMsg := fmt.Sprintf("the expiration for tx %s is different than the one received %d != local %d, make sure all the validators use the same signatures-expiration", txId, receivedExpiration, localExpiration)
+
+	util.SendAlert(api.alertWebhook, "signatures expiration mismatch", errMsg)
+	w.WriteHeader(http.StatusBadRequest)
+	w.Write([]byte(errMsg))
+}
+
+// ethereumTransactionKey returns the key of a submitted Ethereum transaction, the peers not keying the transactions
+// by log index yet do not send it: the bridge event is then looked for by hash among the events of the transaction
+func (api *Api) ethereumTransactionKey(ethTxStore *store.TransactionsStore, tx *bridge_pb.Transaction) (string, error) {
+	if tx.OpId != "" {
+		logIndex, err := strconv.ParseUint(tx.OpId, 10, 64)
+		if err != nil {
+			return "", err
+		}
+
+		return streamer.EthereumTransactionKey(tx.Id, logIndex), nil
+	}
+
+	keys, txs, err := streamer.GetEthereumTransactions(ethTxStore, tx.Id)
+	if err != nil {
+		return "", err
+	}
+
+	for index, ethTx := range txs {
+		if ethTx.Hash == tx.Hash {
+			return keys[index], nil
+		}
+	}
+
+	// a single event is compared with the one submitted
+	if len(keys) == 1 {
+		return keys[0], nil
+	}
+
+	return tx.Id, nil
+}
+
 func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
+	// the span is a child of the broadcast span of the peer
+	ctx, span := tracing.StartServer(r, "SubmitSignature")
+	defer span.End()
+
 	w.Header().Set("Content-Type", "application/json")
 	if r.Method != "POST" {
 		w.WriteHeader(http.StatusBadRequest)
@@ -209,6 +411,12 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	span.SetAttributes(
+		attribute.String("signer", signer),
+		attribute.String("transaction.id", submittedSignature.Transaction.Id),
+		attribute.String("transaction.type", submittedSignature.Transaction.Type.String()),
+	)
+
 	_, found := api.validators[signer]
 	if !found {
 		errMsg := fmt.Sprintf("signer %s is not allowed", signer)
@@ -220,6 +428,16 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 
 	if submittedSignature.Transaction.Type == bridge_pb.TransactionType_ethereum {
 		log.Debugf("received Ethereum tx %s / validators: %+q / signatures: %+q", submittedSignature.Transaction.Id, submittedSignature.Transaction.Validators, submittedSignature.Transaction.Signatures)
+		evmChain := api.evmChains.ByChainId(submittedSignature.Transaction.FromChain)
+		if evmChain == nil {
+			errMsg := fmt.Sprintf("chain %s is not supported", submittedSignature.Transaction.FromChain)
+			log.Errorf(errMsg)
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(errMsg))
+			return
+		}
+		ethTxStore := evmChain.TxStore
+
 		// check transaction hash
 		txIdBytes := common.FromHex(submittedSignature.Transaction.Id)
 
@@ -293,8 +511,10 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 			Chain:         chain,
 		}
 
+		_, hashSpan := tracing.Start(ctx, "CompleteTransferHash")
 		completeTransferHashBytes, err := proto.Marshal(completeTransferHash)
 		if err != nil {
+			hashSpan.End()
 			w.WriteHeader(http.StatusBadRequest)
 			w.Write([]byte("Invalid completeTransferHash"))
 			return
@@ -302,6 +522,7 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 
 		hash := sha256.Sum256(completeTransferHashBytes)
 		hashB64 := base64.URLEncoding.EncodeToString(hash[:])
+		hashSpan.End()
 
 		if hashB64 != submittedSignature.Transaction.Hash {
 			errMsg := fmt.Sprintf("the calulated hash for tx %s is different than the one received %s != calculated %s", submittedSignature.Transaction.Id, submittedSignature.Transaction.Hash, hashB64)
@@ -317,6 +538,14 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		txKey, err := api.ethereumTransactionKey(ethTxStore, submittedSignature.Transaction)
+		if err != nil {
+			log.Errorf(err.Error())
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Invalid opId"))
+			return
+		}
+
 		// check signatures
 		for index, signature := range submittedSignature.Transaction.Signatures {
 			validatorReceived := submittedSignature.Transaction.Validators[index]
@@ -333,6 +562,7 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 			validatorCalculated, err := util.RecoverKoinosAddressFromSignature(signature, hash[:])
 			if err != nil {
 				log.Error(err.Error())
+				api.reportInvalidSignature(signer, txKey, validatorReceived, err.Error(), &submittedSignature)
 				w.WriteHeader(http.StatusBadRequest)
 				w.Write([]byte("cannot recover validator address"))
 				return
@@ -341,6 +571,7 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 			if validatorReceived != validatorCalculated {
 				errMsg := fmt.Sprintf("the signature provided for validator %s does not match the address recovered %s", validatorReceived, validatorCalculated)
 				log.Errorf(errMsg)
+				api.reportInvalidSignature(signer, txKey, validatorReceived, errMsg, &submittedSignature)
 				w.WriteHeader(http.StatusBadRequest)
 				w.Write([]byte(errMsg))
 				return
@@ -348,74 +579,94 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 		}
 
 		// check if we already have this transaction in our store
-		api.ethTxStore.Lock()
-		ethTx, err := api.ethTxStore.Get(submittedSignature.Transaction.Id)
+		ethTxStore.Lock()
+		ethTx, err := ethTxStore.Get(txKey)
 		if err != nil {
 			log.Errorf(err.Error())
 			w.WriteHeader(http.StatusBadRequest)
 			w.Write([]byte("error while getting transaction"))
-			api.ethTxStore.Unlock()
+			ethTxStore.Unlock()
 			return
 		}
 
-		response := ""
+		if ethTx == nil {
+			// not observed by our streamer yet, the transaction is kept apart until it is
+			err = api.putPendingTransaction(evmChain.Name, txKey, signer, submittedSignature.Transaction)
+			ethTxStore.Unlock()
 
-		if ethTx != nil {
-			if ethTx.Status == bridge_pb.TransactionStatus_completed {
-				for index, validatr := range ethTx.Validators {
-					if validatr == api.koinosAddress {
-						response = ethTx.Signatures[index]
-					}
-				}
-				w.WriteHeader(http.StatusOK)
-				w.Write([]byte(response))
-				api.ethTxStore.Unlock()
-				return
+			// our signature is sent back if the transaction can be verified right away
+			signature := ""
+			if err == nil {
+				signature = api.verifyEthereumTransaction(ctx, evmChain, submittedSignature.Transaction.Id, txKey)
 			}
 
-			if ethTx.Hash != hashB64 {
-				errMsg := fmt.Sprintf("the calculated hash for tx %s is different than the one received %s != calculated %s", submittedSignature.Transaction.Hash, ethTx.Hash, hashB64)
-
-				log.Errorf(errMsg)
-				w.WriteHeader(http.StatusBadRequest)
-				w.Write([]byte(errMsg))
-				api.ethTxStore.Unlock()
-				return
-			}
+			api.pendingTransactionResponse(w, signature, err)
+			return
+		}
 
-			signatures := make(map[string]string)
+		response := ""
 
+		if ethTx.Status == bridge_pb.TransactionStatus_completed {
 			for index, validatr := range ethTx.Validators {
-				signatures[validatr] = ethTx.Signatures[index]
-
 				if validatr == api.koinosAddress {
 					response = ethTx.Signatures[index]
 				}
 			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(response))
+			ethTxStore.Unlock()
+			return
+		}
 
-			for index, validatr := range submittedSignature.Transaction.Validators {
-				_, found := signatures[validatr]
-				if !found {
-					signatures[validatr] = submittedSignature.Transaction.Signatures[index]
-				}
+		if ethTx.Expiration != submittedSignature.Transaction.Expiration {
+			api.expirationMismatch(w, txKey, ethTx.Expiration, submittedSignature.Transaction.Expiration)
+			ethTxStore.Unlock()
+			return
+		}
+
+		if ethTx.Hash != hashB64 {
+			errMsg := fmt.Sprintf("the calculated hash for tx %s is different than the one received %s != calculated %s", submittedSignature.Transaction.Hash, ethTx.Hash, hashB64)
+
+			log.Errorf(errMsg)
+			api.reportConflictingTransaction(signer, txKey, api.koinosAddress, &submittedSignature, ethTx)
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(errMsg))
+			ethTxStore.Unlock()
+			return
+		}
+
+		signatures := make(map[string]string)
+
+		for index, validatr := range ethTx.Validators {
+			signatures[validatr] = ethTx.Signatures[index]
+
+			if validatr == api.koinosAddress {
+				response = ethTx.Signatures[index]
 			}
+		}
 
-			ethTx.Validators = []string{}
-			ethTx.Signatures = []string{}
-			for val, sig := range signatures {
-				ethTx.Validators = append(ethTx.Validators, val)
-				ethTx.Signatures = append(ethTx.Signatures, sig)
+		for index, validatr := range submittedSignature.Transaction.Validators {
+			_, found := signatures[validatr]
+			if !found {
+				signatures[validatr] = submittedSignature.Transaction.Signatures[index]
 			}
-		} else {
-			ethTx = submittedSignature.Transaction
 		}
 
-		if len(ethTx.Signatures) >= ((((len(api.validators)/2)*10)/3)*2)/10+1 {
+		ethTx.Validators = []string{}
+		ethTx.Signatures = []string{}
+		for val, sig := range signatures {
+			ethTx.Validators = append(ethTx.Validators, val)
+			ethTx.Signatures = append(ethTx.Signatures, sig)
+		}
+
+		// the signatures of an expired transaction cannot be used anymore
+		if ethTx.Status != bridge_pb.TransactionStatus_expired &&
+			len(ethTx.Signatures) >= ((((len(api.validators)/2)*10)/3)*2)/10+1 {
 			ethTx.Status = bridge_pb.TransactionStatus_signed
 		}
 
-		err = api.ethTxStore.Put(ethTx.Id, ethTx)
-		api.ethTxStore.Unlock()
+		err = ethTxStore.Update(ctx, txKey, ethTx, &bridge_pb.TransactionTransition{Cause: bridge_pb.TransitionCause_peer_submission, Validator: signer})
+		ethTxStore.Unlock()
 
 		if err != nil {
 			log.Errorf(err.Error())
@@ -474,7 +725,18 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 			w.Write([]byte(err.Error()))
 			return
 		} else {
-			_, prefixedHash := util.GenerateEthereumCompleteTransferHash(txIdBytes, operationId, ethToken, recipient, relayer, payment, amount, api.ethContractAddress, submittedSignature.Transaction.Metadata, submittedSignature.Transaction.Expiration, chainId)
+			evmChain := api.evmChains.ByChainId(submittedSignature.Transaction.ToChain)
+			if evmChain == nil {
+				errMsg := fmt.Sprintf("chain %s is not supported", submittedSignature.Transaction.ToChain)
+				log.Errorf(errMsg)
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(errMsg))
+				return
+			}
+
+			_, hashSpan := tracing.Start(ctx, "CompleteTransferHash")
+			_, prefixedHash, _ := util.GenerateEthereumCompleteTransferHash(txIdBytes, operationId, ethToken, recipient, relayer, payment, amount, evmChain.ContractAddr, submittedSignature.Transaction.Metadata, submittedSignature.Transaction.Expiration, chainId)
+			hashSpan.End()
 
 			if prefixedHash.Hex() != submittedSignature.Transaction.Hash {
 				errMsg := fmt.Sprintf("the calulated hash for tx %s is different than the one received %s != calculated %s", submittedSignature.Transaction.Id, submittedSignature.Transaction.Hash, prefixedHash.Hex())
@@ -490,6 +752,8 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 
+			txKey := submittedSignature.Transaction.Id + "-" + submittedSignature.Transaction.OpId
+
 			// check signatures
 			for index, signature := range submittedSignature.Transaction.Signatures {
 				validatorReceived := submittedSignature.Transaction.Validators[index]
@@ -506,6 +770,7 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 				recoveredAddr, err := util.RecoverEthereumAddressFromSignature(signature, prefixedHash.Bytes())
 
 				if err != nil {
+					api.reportInvalidSignature(signer, txKey, validatorReceived, err.Error(), &submittedSignature)
 					w.WriteHeader(http.StatusBadRequest)
 					w.Write([]byte("cannot recover validator address"))
 					return
@@ -514,6 +779,7 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 				if validatorReceived != recoveredAddr {
 					errMsg := fmt.Sprintf("the signature provided for validator %s does not match the address recovered %s", validatorReceived, recoveredAddr)
 					log.Errorf(errMsg)
+					api.reportInvalidSignature(signer, txKey, validatorReceived, errMsg, &submittedSignature)
 					w.WriteHeader(http.StatusBadRequest)
 					w.Write([]byte(errMsg))
 					return
@@ -521,7 +787,6 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 			}
 
 			// check if we already have this transaction in our store
-			txKey := submittedSignature.Transaction.Id + "-" + submittedSignature.Transaction.OpId
 			api.koinosTxStore.Lock()
 			koinosTx, err := api.koinosTxStore.Get(txKey)
 			if err != nil {
@@ -532,63 +797,83 @@ func (api *Api) SubmitSignature(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 
-			response := ""
+			if koinosTx == nil {
+				// not observed by our streamer yet, the transaction is kept apart until it is
+				err = api.putPendingTransaction(streamer.ChainKoinos, txKey, signer, submittedSignature.Transaction)
+				api.koinosTxStore.Unlock()
 
-			if koinosTx != nil {
-				if koinosTx.Status == bridge_pb.TransactionStatus_completed {
-					for index, validatr := range koinosTx.Validators {
-						if validatr == api.ethAddress {
-							response = koinosTx.Signatures[index]
-						}
-					}
-					w.WriteHeader(http.StatusOK)
-					w.Write([]byte(response))
-					api.koinosTxStore.Unlock()
-					return
+				// our signature is sent back if the transaction can be verified right away
+				signature := ""
+				if err == nil {
+					signature = api.verifyKoinosTransaction(ctx, submittedSignature.Transaction.Id, txKey)
 				}
 
-				if koinosTx.Hash != prefixedHash.Hex() {
-					errMsg := fmt.Sprintf("the calculated hash for tx %s is different than the one received %s != calculated %s", submittedSignature.Transaction.Hash, koinosTx.Hash, prefixedHash.Hex())
-
-					log.Errorf(errMsg)
-					w.WriteHeader(http.StatusBadRequest)
-					w.Write([]byte(errMsg))
-					api.koinosTxStore.Unlock()
-					return
-				}
+				api.pendingTransactionResponse(w, signature, err)
+				return
+			}
 
-				signatures := make(map[string]string)
+			response := ""
 
+			if koinosTx.Status == bridge_pb.TransactionStatus_completed {
 				for index, validatr := range koinosTx.Validators {
-					signatures[validatr] = koinosTx.Signatures[index]
-
 					if validatr == api.ethAddress {
 						response = koinosTx.Signatures[index]
 					}
 				}
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(response))
+				api.koinosTxStore.Unlock()
+				return
+			}
 
-				for index, validatr := range submittedSignature.Transaction.Validators {
-					_, found := signatures[validatr]
-					if !found {
-						signatures[validatr] = submittedSignature.Transaction.Signatures[index]
-					}
+			if koinosTx.Expiration != submittedSignature.Transaction.Expiration {
+				api.expirationMismatch(w, txKey, koinosTx.Expiration, submittedSignature.Transaction.Expiration)
+				api.koinosTxStore.Unlock()
+				return
+			}
+
+			if koinosTx.Hash != prefixedHash.Hex() {
+				errMsg := fmt.Sprintf("the calculated hash for tx %s is different than the one received %s != calculated %s", submittedSignature.Transaction.Hash, koinosTx.Hash, prefixedHash.Hex())
+
+				log.Errorf(errMsg)
+				api.reportConflictingTransaction(signer, txKey, api.ethAddress, &submittedSignature, koinosTx)
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(errMsg))
+				api.koinosTxStore.Unlock()
+				return
+			}
+
+			signatures := make(map[string]string)
+
+			for index, validatr := range koinosTx.Validators {
+				signatures[validatr] = koinosTx.Signatures[index]
+
+				if validatr == api.ethAddress {
+					response = koinosTx.Signatures[index]
 				}
+			}
 
-				koinosTx.Validators = []string{}
-				koinosTx.Signatures = []string{}
-				for val, sig := range signatures {
-					koinosTx.Validators = append(koinosTx.Validators, val)
-					koinosTx.Signatures = append(koinosTx.Signatures, sig)
+			for index, validatr := range submittedSignature.Transaction.Validators {
+				_, found := signatures[validatr]
+				if !found {
+					signatures[validatr] = submittedSignature.Transaction.Signatures[index]
 				}
-			} else {
-				koinosTx = submittedSignature.Transaction
 			}
 
-			if len(koinosTx.Signatures) >= ((((len(api.validators)/2)*10)/3)*2)/10+1 {
+			koinosTx.Validators = []string{}
+			koinosTx.Signatures = []string{}
+			for val, sig := range signatures {
+				koinosTx.Validators = append(koinosTx.Validators, val)
+				koinosTx.Signatures = append(koinosTx.Signatures, sig)
+			}
+
+			// the signatures of an expired transaction cannot be used anymore
+			if koinosTx.Status != bridge_pb.TransactionStatus_expired &&
+				len(koinosTx.Signatures) >= ((((len(api.validators)/2)*10)/3)*2)/10+1 {
 				koinosTx.Status = bridge_pb.TransactionStatus_signed
 			}
 
-			err = api.koinosTxStore.Put(txKey, koinosTx)
+			err = api.koinosTxStore.Update(ctx, txKey, koinosTx, &bridge_pb.TransactionTransition{Cause: bridge_pb.TransitionCause_peer_submission, Validator: signer})
 			api.koinosTxStore.Unlock()
 
 			if err != nil {
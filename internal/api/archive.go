@@ -0,0 +1,72 @@
+package api
+
+import (
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// archiveChains returns the name of chain, or of all the EVM chains when nil
+func (api *Api) archiveChains(chain *streamer.EvmChain) []string {
+	if chain != nil {
+		return []string{chain.Name}
+	}
+
+	chains := []string{}
+	for _, evmChain := range api.evmChains {
+		chains = append(chains, evmChain.Name)
+	}
+
+	return chains
+}
+
+// getArchivedTransaction returns the archived transaction txKey of the first of chains archiving it
+func (api *Api) getArchivedTransaction(chains []string, txKey string) *bridge_pb.ArchivedTransaction {
+	for _, chain := range chains {
+		archivedTx, err := api.archiveStore.Get(chain, txKey)
+		if err != nil {
+			log.Error(err.Error())
+			return nil
+		}
+
+		if archivedTx != nil {
+			archivedTx.Transaction.Archived = true
+			return archivedTx
+		}
+	}
+
+	return nil
+}
+
+// getArchivedTransactions returns the archived bridge events (or operations) of the transaction txId
+// of the first of chains archiving it
+func (api *Api) getArchivedTransactions(chains []string, txId string) []*bridge_pb.ArchivedTransaction {
+	for _, chain := range chains {
+		archivedTxs, err := api.archiveStore.GetTransactions(chain, txId)
+		if err != nil {
+			log.Error(err.Error())
+			return nil
+		}
+
+		if len(archivedTxs) > 0 {
+			for _, archivedTx := range archivedTxs {
+				archivedTx.Transaction.Archived = true
+			}
+
+			return archivedTxs
+		}
+	}
+
+	return nil
+}
+
+// archivedTransactions returns the transactions of archivedTxs
+func archivedTransactions(archivedTxs []*bridge_pb.ArchivedTransaction) []*bridge_pb.Transaction {
+	transactions := []*bridge_pb.Transaction{}
+	for _, archivedTx := range archivedTxs {
+		transactions = append(transactions, archivedTx.Transaction)
+	}
+
+	return transactions
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	log "github.com/koinos/koinos-log-golang"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// getTransactionHistory returns the transitions of the transaction txId of chain, of its bridge event (or operation)
+// index only when not empty, in key order
+func (api *Api) getTransactionHistory(chain string, txId string, index string) ([]*bridge_pb.TransactionTransition, error) {
+	transitions := []*bridge_pb.TransactionTransition{}
+
+	prefix := chain + "/" + txId
+	if index != "" {
+		prefix = store.TransactionHistoryPrefix(chain, txId+"-"+index)
+	}
+
+	err := api.historyStore.Iterate(prefix, func(key string, transition *bridge_pb.TransactionTransition) error {
+		// a transaction may also be stored under its id alone
+		if transition.Key == txId || strings.HasPrefix(transition.Key, txId+"-") {
+			transitions = append(transitions, transition)
+		}
+
+		return nil
+	})
+
+	return transitions, err
+}
+
+func (api *Api) GetTransactionHistory(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*") // cors
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	transactionIdParams := r.URL.Query()["TransactionId"]
+
+	if len(transactionIdParams) <= 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Missing TransactionId param"))
+		return
+	}
+
+	// the chain is optional, the transaction is looked for in all the chains otherwise
+	chains := []string{streamer.ChainKoinos}
+	for _, evmChain := range api.evmChains {
+		chains = append(chains, evmChain.Name)
+	}
+
+	chainParams := r.URL.Query()["Chain"]
+	if len(chainParams) > 0 {
+		if chainParams[0] != streamer.ChainKoinos && api.evmChains.Get(chainParams[0]) == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Invalid Chain"))
+			return
+		}
+
+		chains = []string{chainParams[0]}
+	}
+
+	// the log index (or Koinos op id) is optional, the history of all the bridge events of the transaction is returned otherwise
+	index := ""
+	if indexParams := r.URL.Query()["LogIndex"]; len(indexParams) > 0 {
+		index = indexParams[0]
+	} else if indexParams := r.URL.Query()["OpId"]; len(indexParams) > 0 {
+		index = indexParams[0]
+	}
+
+	history := &bridge_pb.TransactionTransitions{}
+
+	for _, chain := range chains {
+		transitions, err := api.getTransactionHistory(chain, transactionIdParams[0], index)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("unknown error"))
+			log.Error(err.Error())
+			return
+		}
+
+		// the history of the archived transactions is archived with them
+		if len(transitions) == 0 {
+			archivedTxs := []*bridge_pb.ArchivedTransaction{}
+			if index != "" {
+				if archivedTx := api.getArchivedTransaction([]string{chain}, transactionIdParams[0]+"-"+index); archivedTx != nil {
+					archivedTxs = append(archivedTxs, archivedTx)
+				}
+			} else {
+				archivedTxs = api.getArchivedTransactions([]string{chain}, transactionIdParams[0])
+			}
+
+			for _, archivedTx := range archivedTxs {
+				transitions = append(transitions, archivedTx.Transitions...)
+			}
+		}
+
+		history.Transitions = append(history.Transitions, transitions...)
+	}
+
+	if len(history.Transitions) == 0 {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("transaction history does not exist"))
+		return
+	}
+
+	// the transitions of the bridge events of the transaction are interleaved chronologically
+	sort.SliceStable(history.Transitions, func(i, j int) bool {
+		return history.Transitions[i].Timestamp < history.Transitions[j].Timestamp
+	})
+
+	m := protojson.MarshalOptions{
+		EmitUnpopulated: true,
+	}
+
+	jsonBytes, err := m.Marshal(history)
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("unknown error"))
+		log.Error(err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonBytes)
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	log "github.com/koinos/koinos-log-golang"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// misbehaviorAlert is the alert sent when a peer misbehaves, the evidence can be queried on /GetMisbehaviors
+type misbehaviorAlert struct {
+	Type          string `json:"type"`
+	Validator     string `json:"validator"`
+	TransactionId string `json:"transactionId"`
+	Details       string `json:"details"`
+}
+
+// reportMisbehavior records the evidence of a peer misbehavior and alerts,
+// an evidence already recorded is not recorded nor alerted again
+func (api *Api) reportMisbehavior(misbehaviorType bridge_pb.MisbehaviorType, validator string, transactionId string, details string, submittedSignature *bridge_pb.SubmittedSignature, localTx *bridge_pb.Transaction) {
+	key := store.MisbehaviorKey(validator, misbehaviorType, transactionId, submittedSignature.Transaction.Hash)
+
+	api.misbehaviorsStore.Lock()
+	defer api.misbehaviorsStore.Unlock()
+
+	evidence, err := api.misbehaviorsStore.Get(key)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	if evidence != nil {
+		return
+	}
+
+	evidence = &bridge_pb.MisbehaviorEvidence{
+		Type:               misbehaviorType,
+		Validator:          validator,
+		TransactionId:      transactionId,
+		Details:            details,
+		DetectedAt:         uint64(time.Now().UnixMilli()),
+		SubmittedSignature: submittedSignature,
+		LocalTransaction:   localTx,
+	}
+
+	err = api.misbehaviorsStore.Put(key, evidence)
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	go util.SendAlert(api.alertWebhook, "peer validator misbehavior", &misbehaviorAlert{
+		Type:          misbehaviorType.String(),
+		Validator:     validator,
+		TransactionId: transactionId,
+		Details:       details,
+	})
+}
+
+// reportConflictingTransaction reports the peers involved in the submission of a transaction whose hash differs
+// from the local one while having the same expiration, so not because of a request_new_signatures cycle.
+// A validator that signed both hashes equivocated, the submitter of a transaction that conflicts
+// with the event observed on chain by this validator (localAddress signed it) submitted a conflicting transaction.
+func (api *Api) reportConflictingTransaction(submitter string, txKey string, localAddress string, submittedSignature *bridge_pb.SubmittedSignature, localTx *bridge_pb.Transaction) {
+	localSignatures := make(map[string]string)
+	for index, validatr := range localTx.Validators {
+		localSignatures[validatr] = localTx.Signatures[index]
+	}
+
+	equivocated := make(map[string]bool)
+
+	for index, validatr := range submittedSignature.Transaction.Validators {
+		localSignature, found := localSignatures[validatr]
+		if !found || validatr == localAddress {
+			continue
+		}
+
+		details := fmt.Sprintf("validator %s signed hash %s (signature %s) and hash %s (signature %s) with the same expiration %d",
+			validatr, localTx.Hash, localSignature, submittedSignature.Transaction.Hash, submittedSignature.Transaction.Signatures[index], localTx.Expiration)
+		api.reportMisbehavior(bridge_pb.MisbehaviorType_equivocation, validatr, txKey, details, submittedSignature, localTx)
+		equivocated[validatr] = true
+	}
+
+	_, observed := localSignatures[localAddress]
+	if observed && !equivocated[submitter] {
+		details := fmt.Sprintf("validator %s submitted hash %s while the event observed on chain hashes to %s", submitter, submittedSignature.Transaction.Hash, localTx.Hash)
+		api.reportMisbehavior(bridge_pb.MisbehaviorType_conflicting_transaction, submitter, txKey, details, submittedSignature, localTx)
+	}
+}
+
+// reportInvalidSignature reports a submitter that sent a signature not matching the validator it is attributed to
+func (api *Api) reportInvalidSignature(submitter string, txKey string, validator string, details string, submittedSignature *bridge_pb.SubmittedSignature) {
+	details = fmt.Sprintf("validator %s submitted an invalid signature for validator %s: %s", submitter, validator, details)
+	api.reportMisbehavior(bridge_pb.MisbehaviorType_invalid_signature, submitter, txKey, details, submittedSignature, nil)
+}
+
+func (api *Api) GetMisbehaviors(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*") // cors
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	// the validator is optional, all the evidences are returned otherwise
+	validator := ""
+	validatorParams := r.URL.Query()["Validator"]
+	if len(validatorParams) > 0 {
+		validator = validatorParams[0] + "-"
+	}
+
+	evidences := &bridge_pb.MisbehaviorEvidences{}
+
+	err := api.misbehaviorsStore.Iterate(validator, func(key string, evidence *bridge_pb.MisbehaviorEvidence) error {
+		evidences.Evidences = append(evidences.Evidences, evidence)
+		return nil
+	})
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("unknown error"))
+		log.Error(err.Error())
+		return
+	}
+
+	m := protojson.MarshalOptions{
+		EmitUnpopulated: true,
+	}
+
+	jsonBytes, err := m.Marshal(evidences)
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("unknown error"))
+		log.Error(err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonBytes)
+}
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	log "github.com/koinos/koinos-log-golang"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+const (
+	// a pending transaction is stale when it was not observed 1 hour after being received
+	pendingStaleAgeDefault = 3600000
+)
+
+// putPendingTransaction keeps a transaction submitted by a peer and not observed yet, the signatures
+// of the peers submitting the same hash are merged. The transactions store of the chain must be locked.
+func (api *Api) putPendingTransaction(chain string, txKey string, submitter string, tx *bridge_pb.Transaction) error {
+	key := store.PendingTransactionKey(chain, txKey, tx.Hash)
+	now := uint64(time.Now().UnixMilli())
+
+	api.pendingTxStore.Lock()
+	defer api.pendingTxStore.Unlock()
+
+	pendingTx, err := api.pendingTxStore.Get(key)
+	if err != nil {
+		return err
+	}
+
+	if pendingTx == nil {
+		// the status is only computed once the transaction is observed
+		pendingTx = &bridge_pb.PendingTransaction{
+			Chain:       chain,
+			Key:         txKey,
+			Transaction: proto.Clone(tx).(*bridge_pb.Transaction),
+			ReceivedAt:  now,
+		}
+		pendingTx.Transaction.Status = bridge_pb.TransactionStatus_gathering_signatures
+	} else {
+		signatures := make(map[string]bool)
+		for _, validatr := range pendingTx.Transaction.Validators {
+			signatures[validatr] = true
+		}
+
+		for index, validatr := range tx.Validators {
+			if !signatures[validatr] {
+				pendingTx.Transaction.Validators = append(pendingTx.Transaction.Validators, validatr)
+				pendingTx.Transaction.Signatures = append(pendingTx.Transaction.Signatures, tx.Signatures[index])
+			}
+		}
+	}
+
+	found := false
+	for _, val := range pendingTx.Submitters {
+		if val == submitter {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		pendingTx.Submitters = append(pendingTx.Submitters, submitter)
+	}
+
+	pendingTx.UpdatedAt = now
+
+	log.Infof("%s tx %s submitted by %s is pending until observed", chain, txKey, submitter)
+
+	return api.pendingTxStore.Put(key, pendingTx)
+}
+
+// verifyEthereumTransaction verifies a transaction not observed by our streamer yet and returns our signature once
+// its events are processed. A transaction that cannot be verified right away (not final yet or RPC error) is verified
+// in the background, our signature is then broadcasted to the peers once its events are processed.
+func (api *Api) verifyEthereumTransaction(ctx context.Context, chain *streamer.EvmChain, txId string, txKey string) string {
+	if api.verifier == nil {
+		return ""
+	}
+
+	err := api.verifier.VerifyEthereumTransactionNow(ctx, chain.Name, txId)
+	if err != nil {
+		log.Infof("cannot verify %s tx %s on demand: %s", chain.Name, txId, err.Error())
+		if !errors.Is(err, streamer.ErrTransactionRejected) {
+			api.verifier.VerifyEthereumTransactionAsync(ctx, chain.Name, txId)
+		}
+		return ""
+	}
+
+	return api.signatureOf(chain.TxStore, txKey, api.koinosAddress)
+}
+
+// verifyKoinosTransaction verifies a transaction not observed by our streamer yet and returns our signature once
+// its events are processed, like verifyEthereumTransaction
+func (api *Api) verifyKoinosTransaction(ctx context.Context, txId string, txKey string) string {
+	if api.verifier == nil {
+		return ""
+	}
+
+	err := api.verifier.VerifyKoinosTransactionNow(ctx, txId)
+	if err != nil {
+		log.Infof("cannot verify Koinos tx %s on demand: %s", txId, err.Error())
+		if !errors.Is(err, streamer.ErrTransactionRejected) {
+			api.verifier.VerifyKoinosTransactionAsync(ctx, txId)
+		}
+		return ""
+	}
+
+	return api.signatureOf(api.koinosTxStore, txKey, api.ethAddress)
+}
+
+// signatureOf returns the signature of validator of the transaction txKey, empty if it is not signed by validator
+func (api *Api) signatureOf(txStore *store.TransactionsStore, txKey string, validator string) string {
+	tx, err := txStore.Get(txKey)
+	if err != nil {
+		log.Error(err.Error())
+		return ""
+	}
+
+	if tx != nil {
+		for index, validatr := range tx.Validators {
+			if validatr == validator {
+				return tx.Signatures[index]
+			}
+		}
+	}
+
+	return ""
+}
+
+// pendingTransactionResponse answers a submission of a transaction not observed before with our signature,
+// empty if the transaction could not be verified yet
+func (api *Api) pendingTransactionResponse(w http.ResponseWriter, signature string, err error) {
+	if err != nil {
+		log.Errorf(err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("error while saving transaction"))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(signature))
+}
+
+func (api *Api) GetStalePendingTransactions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*") // cors
+	if r.Method != "GET" {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("Bad Request"))
+		return
+	}
+
+	minAge := uint64(pendingStaleAgeDefault)
+	minAgeParams := r.URL.Query()["MinAge"]
+	if len(minAgeParams) > 0 {
+		var err error
+		minAge, err = strconv.ParseUint(minAgeParams[0], 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte("Invalid MinAge param"))
+			return
+		}
+	}
+
+	now := uint64(time.Now().UnixMilli())
+	pendingTxs := &bridge_pb.PendingTransactions{}
+
+	err := api.pendingTxStore.Iterate(func(key string, pendingTx *bridge_pb.PendingTransaction) error {
+		if pendingTx.ReceivedAt+minAge <= now {
+			pendingTxs.Transactions = append(pendingTxs.Transactions, pendingTx)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte("unknown error"))
+		log.Error(err.Error())
+		return
+	}
+
+	m := protojson.MarshalOptions{
+		EmitUnpopulated: true,
+	}
+
+	jsonBytes, err := m.Marshal(pendingTxs)
+
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("unknown error"))
+		log.Error(err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(jsonBytes)
+}
@@ -0,0 +1,431 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	log "github.com/koinos/koinos-log-golang"
+	"github.com/koinos/koinos-proto-golang/koinos/contracts/token"
+	kjsonrpc "github.com/koinos/koinos-util-golang/rpc"
+	"github.com/mr-tron/base58"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/rpc"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/streamer"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+const (
+	// entry point of the "balance_of" method of the Koinos token contracts
+	koinosBalanceOfEntryPoint = 0x5c721497
+
+	erc20BalanceOfAbiStr = `[{
+		"constant": true,
+		"inputs": [
+		  {
+			"name": "owner",
+			"type": "address"
+		  }
+		],
+		"name": "balanceOf",
+		"outputs": [
+		  {
+			"name": "balance",
+			"type": "uint256"
+		  }
+		],
+		"stateMutability": "view",
+		"type": "function"
+	  }]`
+
+	nativeChainKoinos = "koinos"
+)
+
+// TokenReport is the reconciliation result for a token bridged between Koinos and an EVM chain
+//
+// All amounts are expressed in the units emitted by the bridge contracts events.
+type TokenReport struct {
+	Chain         string `json:"chain"`
+	EthereumToken string `json:"ethereumToken"`
+	KoinosToken   string `json:"koinosToken"`
+
+	// funds locked on the EVM chain and how much of it was released on Koinos
+	EthereumLocked          string `json:"ethereumLocked"`
+	EthereumLockedCompleted string `json:"ethereumLockedCompleted"`
+
+	// funds locked on Koinos for the EVM chain and how much of it was released on the EVM chain
+	KoinosLocked          string `json:"koinosLocked"`
+	KoinosLockedCompleted string `json:"koinosLockedCompleted"`
+
+	EthereumExpectedBalance string `json:"ethereumExpectedBalance"`
+	EthereumBalance         string `json:"ethereumBalance"`
+
+	Discrepancies []string `json:"discrepancies"`
+}
+
+// KoinosTokenReport is the reconciliation result of the Koinos contract balance of a token,
+// which holds the funds locked for all the EVM chains
+type KoinosTokenReport struct {
+	KoinosToken     string   `json:"koinosToken"`
+	ExpectedBalance string   `json:"expectedBalance"`
+	Balance         string   `json:"balance"`
+	Discrepancies   []string `json:"discrepancies"`
+}
+
+// Report is the result of a reconciliation run
+type Report struct {
+	Timestamp     int64                `json:"timestamp"`
+	Tokens        []*TokenReport       `json:"tokens"`
+	KoinosTokens  []*KoinosTokenReport `json:"koinosTokens"`
+	Discrepancies int                  `json:"discrepancies"`
+	Errors        []string             `json:"errors"`
+}
+
+type tokenTotals struct {
+	tokenConfig             util.TokenConfig
+	ethereumLocked          *big.Int
+	ethereumLockedCompleted *big.Int
+	koinosLocked            *big.Int
+	koinosLockedCompleted   *big.Int
+}
+
+// Reconciler compares the transfers recorded by the validator with the balances held by the bridge contracts
+type Reconciler struct {
+	evmChains          streamer.EvmChains
+	koinosTxStore      *store.TransactionsStore
+	ethClients         map[string]*ethclient.Client
+	koinosClient       *rpc.JsonRPC
+	koinosContractAddr []byte
+	alertWebhook       string
+	erc20Abi           abi.ABI
+
+	lastReport *Report
+	mutex      sync.Mutex
+}
+
+// NewReconciler creates a new Reconciler
+func NewReconciler(
+	evmChains streamer.EvmChains,
+	koinosTxStore *store.TransactionsStore,
+	koinosRPC string,
+	koinosContractStr string,
+	alertWebhook string,
+) (*Reconciler, error) {
+	ethClients := make(map[string]*ethclient.Client)
+	for _, chain := range evmChains {
+		ethCl, err := ethclient.Dial(chain.Rpc)
+		if err != nil {
+			return nil, err
+		}
+
+		ethClients[chain.Name] = ethCl
+	}
+
+	koinosContractAddr, err := base58.Decode(koinosContractStr)
+	if err != nil {
+		return nil, err
+	}
+
+	erc20Abi, err := abi.JSON(strings.NewReader(erc20BalanceOfAbiStr))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reconciler{
+		evmChains:          evmChains,
+		koinosTxStore:      koinosTxStore,
+		ethClients:         ethClients,
+		koinosClient:       rpc.NewJsonRPC(kjsonrpc.NewKoinosRPCClient(koinosRPC)),
+		koinosContractAddr: koinosContractAddr,
+		alertWebhook:       alertWebhook,
+		erc20Abi:           erc20Abi,
+	}, nil
+}
+
+// Run reconciles the balances every interval milliseconds until ctx is done
+func (reconciler *Reconciler) Run(wg *sync.WaitGroup, ctx context.Context, interval uint) {
+	defer wg.Done()
+	defer func() {
+		for _, ethCl := range reconciler.ethClients {
+			ethCl.Close()
+		}
+	}()
+
+	// the first report is available right away on the public API
+	reconciler.Reconcile(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("stop reconciliation")
+			return
+
+		case <-time.After(time.Millisecond * time.Duration(interval)):
+			reconciler.Reconcile(ctx)
+		}
+	}
+}
+
+// LastReport returns the report of the last reconciliation run, nil if none ran yet
+func (reconciler *Reconciler) LastReport() *Report {
+	reconciler.mutex.Lock()
+	defer reconciler.mutex.Unlock()
+
+	return reconciler.lastReport
+}
+
+// Reconcile aggregates the transactions stores per chain and token, compares the totals with
+// the balances of the bridge contracts and alerts on discrepancies
+func (reconciler *Reconciler) Reconcile(ctx context.Context) *Report {
+	report := &Report{
+		Timestamp:    time.Now().UnixMilli(),
+		Tokens:       []*TokenReport{},
+		KoinosTokens: []*KoinosTokenReport{},
+		Errors:       []string{},
+	}
+
+	// totals per chain name and EVM token address
+	totals := make(map[string]map[string]*tokenTotals)
+
+	for _, chain := range reconciler.evmChains {
+		chainTotals := make(map[string]*tokenTotals)
+		totals[chain.Name] = chainTotals
+
+		for _, tokenConfig := range chain.TokenAddresses {
+			chainTotals[common.HexToAddress(tokenConfig.EthereumAddress).Hex()] = &tokenTotals{
+				tokenConfig:             tokenConfig,
+				ethereumLocked:          new(big.Int),
+				ethereumLockedCompleted: new(big.Int),
+				koinosLocked:            new(big.Int),
+				koinosLockedCompleted:   new(big.Int),
+			}
+		}
+
+		err := chain.TxStore.Iterate(func(key string, tx *bridge_pb.Transaction) error {
+			total, amount := getTotalAndAmount(chainTotals, tx, report)
+			if total == nil {
+				return nil
+			}
+
+			total.ethereumLocked.Add(total.ethereumLocked, amount)
+			if tx.Status == bridge_pb.TransactionStatus_completed {
+				total.ethereumLockedCompleted.Add(total.ethereumLockedCompleted, amount)
+			}
+
+			return nil
+		})
+		if err != nil {
+			report.Errors = append(report.Errors, err.Error())
+		}
+	}
+
+	err := reconciler.koinosTxStore.Iterate(func(key string, tx *bridge_pb.Transaction) error {
+		// transactions only known through a completion event do not have any details
+		if tx.EthToken == "" {
+			return nil
+		}
+
+		chain := reconciler.evmChains.ByChainId(tx.ToChain)
+		if chain == nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("tx %s is sent to chain %s which is not configured", tx.Id, tx.ToChain))
+			return nil
+		}
+
+		total, amount := getTotalAndAmount(totals[chain.Name], tx, report)
+		if total == nil {
+			return nil
+		}
+
+		total.koinosLocked.Add(total.koinosLocked, amount)
+		if tx.Status == bridge_pb.TransactionStatus_completed {
+			total.koinosLockedCompleted.Add(total.koinosLockedCompleted, amount)
+		}
+
+		return nil
+	})
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+
+	// the Koinos contract holds what was locked on Koinos minus what was released for the EVM chains transfers
+	koinosExpected := make(map[string]*big.Int)
+
+	for _, chain := range reconciler.evmChains {
+		chainTotals := totals[chain.Name]
+
+		ethTokens := make([]string, 0, len(chainTotals))
+		for ethToken := range chainTotals {
+			ethTokens = append(ethTokens, ethToken)
+		}
+		sort.Strings(ethTokens)
+
+		for _, ethToken := range ethTokens {
+			total := chainTotals[ethToken]
+			tokenConfig := total.tokenConfig
+
+			tokenReport := &TokenReport{
+				Chain:                   chain.Name,
+				EthereumToken:           ethToken,
+				KoinosToken:             tokenConfig.KoinosAddress,
+				EthereumLocked:          total.ethereumLocked.String(),
+				EthereumLockedCompleted: total.ethereumLockedCompleted.String(),
+				KoinosLocked:            total.koinosLocked.String(),
+				KoinosLockedCompleted:   total.koinosLockedCompleted.String(),
+				Discrepancies:           []string{},
+			}
+
+			// the EVM contract holds what was locked on the EVM chain minus what was released for Koinos transfers
+			ethExpected := new(big.Int).Sub(total.ethereumLocked, total.koinosLockedCompleted)
+
+			if tokenConfig.NativeChain != nativeChainKoinos {
+				tokenReport.EthereumExpectedBalance = ethExpected.String()
+
+				ethBalance, err := reconciler.getEthereumBalance(ctx, chain, common.HexToAddress(ethToken))
+				if err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("cannot get %s balance of token %s: %s", chain.Name, ethToken, err))
+				} else {
+					tokenReport.EthereumBalance = ethBalance.String()
+					checkBalance(&tokenReport.Discrepancies, chain.Name, ethExpected, ethBalance)
+				}
+			}
+
+			if tokenConfig.NativeChain == "" || tokenConfig.NativeChain == nativeChainKoinos {
+				expected, found := koinosExpected[tokenConfig.KoinosAddress]
+				if !found {
+					expected = new(big.Int)
+					koinosExpected[tokenConfig.KoinosAddress] = expected
+				}
+
+				expected.Add(expected, total.koinosLocked)
+				expected.Sub(expected, total.ethereumLockedCompleted)
+			}
+
+			report.Discrepancies += len(tokenReport.Discrepancies)
+			report.Tokens = append(report.Tokens, tokenReport)
+		}
+	}
+
+	koinosTokens := make([]string, 0, len(koinosExpected))
+	for koinosToken := range koinosExpected {
+		koinosTokens = append(koinosTokens, koinosToken)
+	}
+	sort.Strings(koinosTokens)
+
+	for _, koinosToken := range koinosTokens {
+		expected := koinosExpected[koinosToken]
+
+		koinosTokenReport := &KoinosTokenReport{
+			KoinosToken:     koinosToken,
+			ExpectedBalance: expected.String(),
+			Discrepancies:   []string{},
+		}
+
+		koinosBalance, err := reconciler.getKoinosBalance(ctx, koinosToken)
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("cannot get Koinos balance of token %s: %s", koinosToken, err))
+		} else {
+			koinosTokenReport.Balance = koinosBalance.String()
+			checkBalance(&koinosTokenReport.Discrepancies, "Koinos", expected, koinosBalance)
+		}
+
+		report.Discrepancies += len(koinosTokenReport.Discrepancies)
+		report.KoinosTokens = append(report.KoinosTokens, koinosTokenReport)
+	}
+
+	if report.Discrepancies > 0 {
+		util.SendAlert(reconciler.alertWebhook, "bridge balances reconciliation discrepancies", report)
+	} else {
+		log.Infof("reconciliation completed: %d tokens, no discrepancies", len(report.Tokens))
+	}
+
+	for _, reportErr := range report.Errors {
+		log.Warnf("reconciliation: %s", reportErr)
+	}
+
+	reconciler.mutex.Lock()
+	reconciler.lastReport = report
+	reconciler.mutex.Unlock()
+
+	return report
+}
+
+func getTotalAndAmount(totals map[string]*tokenTotals, tx *bridge_pb.Transaction, report *Report) (*tokenTotals, *big.Int) {
+	// transactions only known through a completion event do not have any details
+	if tx.EthToken == "" {
+		return nil, nil
+	}
+
+	total, found := totals[common.HexToAddress(tx.EthToken).Hex()]
+	if !found {
+		report.Errors = append(report.Errors, fmt.Sprintf("tx %s uses unsupported token %s", tx.Id, tx.EthToken))
+		return nil, nil
+	}
+
+	amount, ok := new(big.Int).SetString(tx.Amount, 10)
+	if !ok {
+		report.Errors = append(report.Errors, fmt.Sprintf("tx %s has an invalid amount %s", tx.Id, tx.Amount))
+		return nil, nil
+	}
+
+	return total, amount
+}
+
+func checkBalance(discrepancies *[]string, chain string, expected *big.Int, balance *big.Int) {
+	if expected.Sign() < 0 {
+		*discrepancies = append(*discrepancies, fmt.Sprintf("more funds released than locked on %s (%s)", chain, expected))
+	}
+
+	if balance.Cmp(expected) < 0 {
+		*discrepancies = append(*discrepancies, fmt.Sprintf("%s contract balance %s is lower than expected %s", chain, balance, expected))
+	}
+}
+
+func (reconciler *Reconciler) getEthereumBalance(ctx context.Context, chain *streamer.EvmChain, tokenAddr common.Address) (*big.Int, error) {
+	data, err := reconciler.erc20Abi.Pack("balanceOf", chain.ContractAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := reconciler.ethClients[chain.Name].CallContract(ctx, ethereum.CallMsg{To: &tokenAddr, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(result), nil
+}
+
+func (reconciler *Reconciler) getKoinosBalance(ctx context.Context, tokenStr string) (*big.Int, error) {
+	tokenAddr, err := base58.Decode(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	args, err := proto.Marshal(&token.BalanceOfArguments{Owner: reconciler.koinosContractAddr})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := reconciler.koinosClient.ReadContract(ctx, tokenAddr, koinosBalanceOfEntryPoint, args)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &token.BalanceOfResult{}
+	err = proto.Unmarshal(resp.Result, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetUint64(result.Value), nil
+}
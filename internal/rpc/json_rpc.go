@@ -6,15 +6,22 @@ import (
 	"github.com/koinos/koinos-proto-golang/koinos/protocol"
 	"github.com/koinos/koinos-proto-golang/koinos/rpc/block_store"
 	chainrpc "github.com/koinos/koinos-proto-golang/koinos/rpc/chain"
+	"github.com/koinos/koinos-proto-golang/koinos/rpc/contract_meta_store"
+	"github.com/koinos/koinos-proto-golang/koinos/rpc/transaction_store"
 	kjsonrpc "github.com/koinos/koinos-util-golang/rpc"
 	"github.com/multiformats/go-multihash"
 )
 
 // RPC service constants
 const (
-	GetHeadInfoCall       = "chain.get_head_info"
-	GetBlocksByHeightCall = "block_store.get_blocks_by_height"
-	SubmitBlockCall       = "chain.submit_block"
+	GetHeadInfoCall         = "chain.get_head_info"
+	GetBlocksByHeightCall   = "block_store.get_blocks_by_height"
+	SubmitBlockCall         = "chain.submit_block"
+	ReadContractCall        = "chain.read_contract"
+	GetBlocksByIdCall       = "block_store.get_blocks_by_id"
+	GetTransactionsByIdCall = "transaction_store.get_transactions_by_id"
+	GetChainIdCall          = "chain.get_chain_id"
+	GetContractMetaCall     = "contract_meta_store.get_contract_meta"
 )
 
 // JsonRPC
@@ -71,3 +78,80 @@ func (k *JsonRPC) ApplyBlock(ctx context.Context, block *protocol.Block) (*chain
 
 	return submitBlockResp, nil
 }
+
+func (k *JsonRPC) ReadContract(ctx context.Context, contractID []byte, entryPoint uint32, args []byte) (*chainrpc.ReadContractResponse, error) {
+	params := chainrpc.ReadContractRequest{
+		ContractId: contractID,
+		EntryPoint: entryPoint,
+		Args:       args,
+	}
+
+	readContractResp := &chainrpc.ReadContractResponse{}
+
+	err := k.client.Call(ctx, ReadContractCall, &params, readContractResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return readContractResp, nil
+}
+
+func (k *JsonRPC) GetBlocksById(ctx context.Context, blockIDs [][]byte) (*block_store.GetBlocksByIdResponse, error) {
+	params := block_store.GetBlocksByIdRequest{
+		BlockIds:      blockIDs,
+		ReturnBlock:   true,
+		ReturnReceipt: true,
+	}
+
+	blockResponse := &block_store.GetBlocksByIdResponse{}
+
+	err := k.client.Call(ctx, GetBlocksByIdCall, &params, blockResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockResponse, nil
+}
+
+func (k *JsonRPC) GetTransactionsById(ctx context.Context, transactionIDs [][]byte) (*transaction_store.GetTransactionsByIdResponse, error) {
+	params := transaction_store.GetTransactionsByIdRequest{
+		TransactionIds: transactionIDs,
+	}
+
+	transactionsResponse := &transaction_store.GetTransactionsByIdResponse{}
+
+	err := k.client.Call(ctx, GetTransactionsByIdCall, &params, transactionsResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactionsResponse, nil
+}
+
+func (k *JsonRPC) GetChainId(ctx context.Context) (*chainrpc.GetChainIdResponse, error) {
+	params := chainrpc.GetChainIdRequest{}
+
+	chainIdResponse := &chainrpc.GetChainIdResponse{}
+
+	err := k.client.Call(ctx, GetChainIdCall, &params, chainIdResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return chainIdResponse, nil
+}
+
+func (k *JsonRPC) GetContractMeta(ctx context.Context, contractID []byte) (*contract_meta_store.GetContractMetaResponse, error) {
+	params := contract_meta_store.GetContractMetaRequest{
+		ContractId: contractID,
+	}
+
+	contractMetaResponse := &contract_meta_store.GetContractMetaResponse{}
+
+	err := k.client.Call(ctx, GetContractMetaCall, &params, contractMetaResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return contractMetaResponse, nil
+}
@@ -0,0 +1,217 @@
+package store
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ArchiveStore keeps the transactions pruned from the transactions stores in gzipped JSON lines files,
+// the index backend maps the key of each archived transaction to its file
+type ArchiveStore struct {
+	dir     string
+	index   Backend
+	rwmutex sync.RWMutex
+}
+
+// NewArchiveStore creates a new ArchiveStore writing its files in dir
+func NewArchiveStore(dir string, index Backend) (*ArchiveStore, error) {
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArchiveStore{dir: dir, index: index}, nil
+}
+
+// ArchiveKey returns the index key of the archived transaction txKey of chain
+func ArchiveKey(chain string, txKey string) string {
+	return chain + "/" + txKey
+}
+
+// Archive writes the transactions to a new archive file and indexes them, the file is complete
+// before the transactions are indexed so that they can be pruned once Archive returns
+func (handler *ArchiveStore) Archive(name string, archivedTxs []*bridge_pb.ArchivedTransaction) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	now := uint64(time.Now().UnixMilli())
+	fileName := fmt.Sprintf("%s-%d.jsonl.gz", name, now)
+
+	err := handler.write(fileName, now, archivedTxs)
+	if err != nil {
+		return err
+	}
+
+	for _, archivedTx := range archivedTxs {
+		err = handler.index.Put([]byte(ArchiveKey(archivedTx.Chain, archivedTx.Key)), []byte(fileName))
+		if err != nil {
+			return fmt.Errorf("%w, %v", ErrBackend, err)
+		}
+	}
+
+	return nil
+}
+
+func (handler *ArchiveStore) write(fileName string, archivedAt uint64, archivedTxs []*bridge_pb.ArchivedTransaction) error {
+	tmpPath := path.Join(handler.dir, fileName+".tmp")
+
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	zipWriter := gzip.NewWriter(file)
+
+	for _, archivedTx := range archivedTxs {
+		archivedTx.ArchivedAt = archivedAt
+
+		line, err := protojson.Marshal(archivedTx)
+		if err != nil {
+			return fmt.Errorf("%w, %v", ErrSerialization, err)
+		}
+
+		_, err = zipWriter.Write(append(line, '\n'))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = zipWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	err = file.Sync()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path.Join(handler.dir, fileName))
+}
+
+// Get returns the archived transaction txKey of chain, nil if it is not archived
+func (handler *ArchiveStore) Get(chain string, txKey string) (*bridge_pb.ArchivedTransaction, error) {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	key := ArchiveKey(chain, txKey)
+
+	fileName, err := handler.index.Get([]byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	if len(fileName) == 0 {
+		return nil, nil
+	}
+
+	archivedTxs, err := handler.read(string(fileName), map[string]bool{key: true})
+	if err != nil || len(archivedTxs) == 0 {
+		return nil, err
+	}
+
+	return archivedTxs[0], nil
+}
+
+// GetTransactions returns the archived bridge events (or operations) of the transaction txId of chain
+func (handler *ArchiveStore) GetTransactions(chain string, txId string) ([]*bridge_pb.ArchivedTransaction, error) {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	// the keys archived in each file
+	files := make(map[string]map[string]bool)
+
+	err := handler.index.Iterate([]byte(ArchiveKey(chain, txId)), func(key []byte, value []byte) error {
+		// a transaction may also be stored under its id alone
+		txKey := strings.TrimPrefix(string(key), chain+"/")
+		if txKey != txId && !strings.HasPrefix(txKey, txId+"-") {
+			return nil
+		}
+
+		if files[string(value)] == nil {
+			files[string(value)] = make(map[string]bool)
+		}
+		files[string(value)][string(key)] = true
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	archivedTxs := []*bridge_pb.ArchivedTransaction{}
+	for fileName, keys := range files {
+		fileTxs, err := handler.read(fileName, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		archivedTxs = append(archivedTxs, fileTxs...)
+	}
+
+	return archivedTxs, nil
+}
+
+// read returns the transactions of the archive file whose index key is in keys
+func (handler *ArchiveStore) read(fileName string, keys map[string]bool) ([]*bridge_pb.ArchivedTransaction, error) {
+	file, err := os.Open(path.Join(handler.dir, fileName))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	zipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer zipReader.Close()
+
+	archivedTxs := []*bridge_pb.ArchivedTransaction{}
+
+	scanner := bufio.NewScanner(zipReader)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		archivedTx := &bridge_pb.ArchivedTransaction{}
+		err = protojson.Unmarshal(scanner.Bytes(), archivedTx)
+		if err != nil {
+			return nil, fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		if keys[ArchiveKey(archivedTx.Chain, archivedTx.Key)] {
+			archivedTxs = append(archivedTxs, archivedTx)
+		}
+	}
+
+	return archivedTxs, scanner.Err()
+}
+
+// Size returns the size in bytes of the archive files
+func (handler *ArchiveStore) Size() (int64, error) {
+	size := int64(0)
+
+	err := filepath.Walk(handler.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
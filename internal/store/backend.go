@@ -14,6 +14,17 @@ type Backend interface {
 	 */
 	Get(key []byte) ([]byte, error)
 
+	/**
+	 * Delete the given key, deleting a missing key is not an error.
+	 */
+	Delete(key []byte) error
+
+	/**
+	 * Iterate over the stored key/value pairs whose key starts with prefix,
+	 * in key order. Iteration stops at the first error returned by fn.
+	 */
+	Iterate(prefix []byte, fn func(key []byte, value []byte) error) error
+
 	// Resets the entire database
 	Reset() error
 }
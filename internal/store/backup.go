@@ -0,0 +1,245 @@
+package store
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// BackupVersion is the version of the backups written, it changes with the backup format or the layout of the records
+const BackupVersion = 1
+
+// ErrBackupCorrupted is returned when a backup is truncated or its content does not match its checksum
+var ErrBackupCorrupted = errors.New("backup corrupted")
+
+// BackupDatabase is a database included in a backup
+type BackupDatabase struct {
+	Name    string
+	Backend Backend
+}
+
+// BackupHeader describes a backup, it is its first line
+type BackupHeader struct {
+	Version   uint     `json:"version"`
+	CreatedAt uint64   `json:"createdAt"`
+	Databases []string `json:"databases"`
+}
+
+// BackupTrailer closes a backup, it is its last line
+type BackupTrailer struct {
+	Records uint64 `json:"records"`
+	// sha256 of all the lines before the trailer
+	Checksum string `json:"checksum"`
+}
+
+type backupRecord struct {
+	Database string `json:"database"`
+	Key      []byte `json:"key"`
+	Value    []byte `json:"value"`
+}
+
+// backupLine is a line of a backup, gzipped JSON lines made of a header, the records and a trailer
+type backupLine struct {
+	Header  *BackupHeader  `json:"header,omitempty"`
+	Record  *backupRecord  `json:"record,omitempty"`
+	Trailer *BackupTrailer `json:"trailer,omitempty"`
+}
+
+// Backup writes a backup of the databases to w, each database is read in a single iteration so that its backup
+// is a snapshot with the badger backend. The databases are backed up in order, one after the other, so the backup
+// is not a snapshot across the databases.
+func Backup(w io.Writer, databases []BackupDatabase) (*BackupTrailer, error) {
+	zipWriter := gzip.NewWriter(w)
+	checksum := sha256.New()
+	encoder := json.NewEncoder(io.MultiWriter(zipWriter, checksum))
+
+	header := &BackupHeader{
+		Version:   BackupVersion,
+		CreatedAt: uint64(time.Now().UnixMilli()),
+	}
+	for _, database := range databases {
+		header.Databases = append(header.Databases, database.Name)
+	}
+
+	err := encoder.Encode(&backupLine{Header: header})
+	if err != nil {
+		return nil, err
+	}
+
+	trailer := &BackupTrailer{}
+
+	for _, database := range databases {
+		err = database.Backend.Iterate(nil, func(key []byte, value []byte) error {
+			trailer.Records++
+			return encoder.Encode(&backupLine{Record: &backupRecord{Database: database.Name, Key: key, Value: value}})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+		}
+	}
+
+	trailer.Checksum = hex.EncodeToString(checksum.Sum(nil))
+
+	err = json.NewEncoder(zipWriter).Encode(&backupLine{Trailer: trailer})
+	if err != nil {
+		return nil, err
+	}
+
+	return trailer, zipWriter.Close()
+}
+
+// VerifyBackup checks the version and the integrity of the backup read from r, it returns its header and trailer
+func VerifyBackup(r io.Reader) (*BackupHeader, *BackupTrailer, error) {
+	return readBackup(r, nil)
+}
+
+// BackupRestorer restores the databases of a backup into fresh backends, then swaps them with the databases
+type BackupRestorer interface {
+	// Create returns a fresh and empty backend to restore the database with the given name into
+	Create(name string) (Backend, error)
+	// Swap replaces the databases with their restored backends, either all of them or none
+	Swap(names []string) error
+	// Discard removes the restored backends, the databases are left untouched
+	Discard(names []string)
+}
+
+// RestoreBackup verifies the backup read from r, then restores each database it contains into a fresh backend
+// created by restorer. The databases are only replaced once all the records are restored, a failure leaves them untouched.
+func RestoreBackup(r io.ReadSeeker, restorer BackupRestorer) (*BackupHeader, *BackupTrailer, error) {
+	header, _, err := VerifyBackup(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = r.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := []string{}
+	backends := make(map[string]Backend)
+	for _, name := range header.Databases {
+		backend, err := restorer.Create(name)
+		if err != nil {
+			restorer.Discard(created)
+			return nil, nil, err
+		}
+		created = append(created, name)
+		backends[name] = backend
+	}
+
+	_, trailer, err := readBackup(r, func(record *backupRecord) error {
+		err := backends[record.Database].Put(record.Key, record.Value)
+		if err != nil {
+			return fmt.Errorf("%w, %v", ErrBackend, err)
+		}
+
+		return nil
+	})
+	if err == nil {
+		err = restorer.Swap(created)
+	}
+	if err != nil {
+		restorer.Discard(created)
+		return nil, nil, err
+	}
+
+	return header, trailer, nil
+}
+
+// readBackup reads the backup and calls fn, when not nil, for each record
+func readBackup(r io.Reader, fn func(record *backupRecord) error) (*BackupHeader, *BackupTrailer, error) {
+	zipReader, err := gzip.NewReader(bufio.NewReader(r))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w, %v", ErrBackupCorrupted, err)
+	}
+	defer zipReader.Close()
+
+	reader := bufio.NewReader(zipReader)
+	checksum := sha256.New()
+
+	line := &backupLine{}
+	lineBytes, err := readBackupLine(reader, line)
+	if err != nil {
+		return nil, nil, err
+	}
+	checksum.Write(lineBytes)
+
+	header := line.Header
+	if header == nil {
+		return nil, nil, fmt.Errorf("%w, missing header", ErrBackupCorrupted)
+	}
+
+	if header.Version != BackupVersion {
+		return nil, nil, fmt.Errorf("unsupported backup version %d, expected %d", header.Version, BackupVersion)
+	}
+
+	databases := make(map[string]bool)
+	for _, name := range header.Databases {
+		databases[name] = true
+	}
+
+	records := uint64(0)
+
+	for {
+		line = &backupLine{}
+		lineBytes, err = readBackupLine(reader, line)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if line.Trailer != nil {
+			// the checksum covers the lines before the trailer
+			sum := checksum.Sum(nil)
+			trailer := line.Trailer
+			if trailer.Records != records || trailer.Checksum != hex.EncodeToString(sum) {
+				return nil, nil, fmt.Errorf("%w, %d records with checksum %x, expected %d records with checksum %s", ErrBackupCorrupted, records, sum, trailer.Records, trailer.Checksum)
+			}
+
+			_, err = reader.ReadByte()
+			if err != io.EOF {
+				return nil, nil, fmt.Errorf("%w, data after the trailer", ErrBackupCorrupted)
+			}
+
+			return header, trailer, nil
+		}
+
+		checksum.Write(lineBytes)
+
+		record := line.Record
+		if record == nil || !databases[record.Database] {
+			return nil, nil, fmt.Errorf("%w, invalid record %d", ErrBackupCorrupted, records+1)
+		}
+		records++
+
+		if fn != nil {
+			err = fn(record)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+}
+
+// readBackupLine decodes the next line of the backup into line, it returns the bytes of the line
+func readBackupLine(reader *bufio.Reader, line *backupLine) ([]byte, error) {
+	lineBytes, err := reader.ReadBytes('\n')
+	if err == io.EOF {
+		return nil, fmt.Errorf("%w, truncated", ErrBackupCorrupted)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackupCorrupted, err)
+	}
+
+	err = json.Unmarshal(lineBytes, line)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackupCorrupted, err)
+	}
+
+	return lineBytes, nil
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// testRestorer restores into map backends, swap fails when failSwap is set
+type testRestorer struct {
+	databases map[string]*MapBackend
+	restored  map[string]*MapBackend
+	failSwap  bool
+}
+
+func (restorer *testRestorer) Create(name string) (Backend, error) {
+	restorer.restored[name] = NewMapBackend()
+	return restorer.restored[name], nil
+}
+
+func (restorer *testRestorer) Swap(names []string) error {
+	if restorer.failSwap {
+		return errors.New("cannot swap")
+	}
+
+	for _, name := range names {
+		restorer.databases[name] = restorer.restored[name]
+	}
+
+	return nil
+}
+
+func (restorer *testRestorer) Discard(names []string) {
+	for _, name := range names {
+		delete(restorer.restored, name)
+	}
+}
+
+func TestBackupRestore(t *testing.T) {
+	metadata := NewMapBackend()
+	transactions := NewMapBackend()
+	metadata.Put([]byte("checkpoint"), []byte("42"))
+	transactions.Put([]byte("0x01-1"), []byte("tx1"))
+	transactions.Put([]byte("0x02-1"), []byte("tx2"))
+
+	var buffer bytes.Buffer
+	trailer, err := Backup(&buffer, []BackupDatabase{{Name: "metadata", Backend: metadata}, {Name: "transactions", Backend: transactions}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trailer.Records != 3 {
+		t.Fatalf("expected 3 records, got %d", trailer.Records)
+	}
+
+	current := NewMapBackend()
+	current.Put([]byte("0x03-1"), []byte("tx3"))
+
+	// a failed swap leaves the databases untouched
+	restorer := &testRestorer{
+		databases: map[string]*MapBackend{"metadata": NewMapBackend(), "transactions": current},
+		restored:  make(map[string]*MapBackend),
+		failSwap:  true,
+	}
+
+	_, _, err = RestoreBackup(bytes.NewReader(buffer.Bytes()), restorer)
+	if err == nil {
+		t.Fatal("expected the restore to fail")
+	}
+	if restorer.databases["transactions"] != current || len(restorer.restored) != 0 {
+		t.Fatal("expected the databases to be untouched and the restored ones discarded")
+	}
+	if value, _ := current.Get([]byte("0x03-1")); string(value) != "tx3" {
+		t.Fatal("expected the records of the database to be kept")
+	}
+
+	restorer.failSwap = false
+	header, trailer, err := RestoreBackup(bytes.NewReader(buffer.Bytes()), restorer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(header.Databases) != 2 || trailer.Records != 3 {
+		t.Fatalf("expected 3 records of 2 databases, got %d records of %d databases", trailer.Records, len(header.Databases))
+	}
+
+	restoredTransactions := restorer.databases["transactions"]
+	if value, _ := restoredTransactions.Get([]byte("0x02-1")); string(value) != "tx2" {
+		t.Fatal("expected the transactions to be restored")
+	}
+	if value, _ := restoredTransactions.Get([]byte("0x03-1")); len(value) != 0 {
+		t.Fatal("expected the records missing from the backup to be gone")
+	}
+	if value, _ := restorer.databases["metadata"].Get([]byte("checkpoint")); string(value) != "42" {
+		t.Fatal("expected the metadata to be restored")
+	}
+
+	// a corrupted backup is not restored
+	corrupted := buffer.Bytes()[:buffer.Len()-8]
+	_, _, err = RestoreBackup(bytes.NewReader(corrupted), restorer)
+	if !errors.Is(err, ErrBackupCorrupted) {
+		t.Fatalf("expected ErrBackupCorrupted, got %v", err)
+	}
+}
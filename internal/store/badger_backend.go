@@ -2,6 +2,8 @@ package store
 
 import (
 	"errors"
+	"fmt"
+	"runtime"
 	"strings"
 
 	"github.com/dgraph-io/badger/v3"
@@ -14,9 +16,13 @@ type BadgerBackend struct {
 }
 
 // NewBadgerBackend BadgerBackend constructor
-func NewBadgerBackend(opts badger.Options) *BadgerBackend {
-	badgerDB, _ := badger.Open(opts)
-	return &BadgerBackend{DB: badgerDB}
+func NewBadgerBackend(opts badger.Options) (*BadgerBackend, error) {
+	badgerDB, err := badger.Open(opts)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return &BadgerBackend{DB: badgerDB}, nil
 }
 
 // Close cleans backend resources
@@ -39,6 +45,13 @@ func (backend *BadgerBackend) Put(key, value []byte) error {
 	})
 }
 
+// Delete backend deleter
+func (backend *BadgerBackend) Delete(key []byte) error {
+	return backend.DB.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
 // Get backend getter
 func (backend *BadgerBackend) Get(key []byte) ([]byte, error) {
 	var value []byte = nil
@@ -60,6 +73,64 @@ func (backend *BadgerBackend) Get(key []byte) ([]byte, error) {
 	return value, err
 }
 
+// Iterate backend iterator
+func (backend *BadgerBackend) Iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	return backend.DB.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			err = fn(item.KeyCopy(nil), value)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Compact rewrites the LSM tree and garbage collects the value log
+func (backend *BadgerBackend) Compact() error {
+	err := backend.DB.Flatten(runtime.NumCPU())
+	if err != nil {
+		return err
+	}
+
+	_, err = backend.RunValueLogGC(0.5)
+	return err
+}
+
+// RunValueLogGC rewrites the value log files until none has discardRatio of its space to reclaim,
+// it returns the number of files rewritten
+func (backend *BadgerBackend) RunValueLogGC(discardRatio float64) (int, error) {
+	count := 0
+
+	for {
+		err := backend.DB.RunValueLogGC(discardRatio)
+		if err == badger.ErrNoRewrite {
+			return count, nil
+		} else if err != nil {
+			return count, err
+		}
+
+		count++
+	}
+}
+
+// Size returns the size in bytes of the LSM tree and of the value log
+func (backend *BadgerBackend) Size() (int64, int64) {
+	return backend.DB.Size()
+}
+
 // KoinosBadgerLogger implements the badger.Logger interface in roder to pass badger logs the the koinos logger
 type KoinosBadgerLogger struct {
 }
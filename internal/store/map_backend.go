@@ -3,6 +3,8 @@ package store
 import (
 	"encoding/hex"
 	"errors"
+	"sort"
+	"strings"
 )
 
 // MapBackend implements a key-value store backed by a simple map
@@ -35,6 +37,12 @@ func (backend *MapBackend) Put(key []byte, value []byte) error {
 	return nil
 }
 
+// Delete removes the requested key from the database
+func (backend *MapBackend) Delete(key []byte) error {
+	delete(backend.storage, hex.EncodeToString(key))
+	return nil
+}
+
 // Get fetches the requested value from the database
 func (backend *MapBackend) Get(key []byte) ([]byte, error) {
 	if len(key) == 0 {
@@ -49,3 +57,29 @@ func (backend *MapBackend) Get(key []byte) ([]byte, error) {
 
 	return make([]byte, 0), nil
 }
+
+// Iterate calls fn for each key/value pair whose key starts with prefix, in key order
+func (backend *MapBackend) Iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	keys := make([]string, 0, len(backend.storage))
+	p := hex.EncodeToString(prefix)
+	for k := range backend.storage {
+		if strings.HasPrefix(k, p) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		key, err := hex.DecodeString(k)
+		if err != nil {
+			return err
+		}
+
+		err = fn(key, backend.storage[k])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
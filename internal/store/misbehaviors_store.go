@@ -0,0 +1,80 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// MisbehaviorsStore records the evidences of peer validators misbehaviors
+type MisbehaviorsStore struct {
+	backend Backend
+	rwmutex sync.RWMutex
+	sync.Mutex
+}
+
+// NewMisbehaviorsStore creates a new MisbehaviorsStore wrapping the provided backend
+func NewMisbehaviorsStore(backend Backend) *MisbehaviorsStore {
+	return &MisbehaviorsStore{backend: backend}
+}
+
+// MisbehaviorKey returns the key of an evidence, a peer repeating the same misbehavior
+// for the same transaction and hash is only recorded once
+func MisbehaviorKey(validator string, misbehaviorType bridge_pb.MisbehaviorType, transactionId string, hash string) string {
+	return fmt.Sprintf("%s-%s-%s-%s", validator, misbehaviorType, transactionId, hash)
+}
+
+func (handler *MisbehaviorsStore) Put(key string, evidence *bridge_pb.MisbehaviorEvidence) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	itemBytes, err := proto.Marshal(evidence)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrSerialization, err)
+	}
+
+	err = handler.backend.Put([]byte(key), itemBytes)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return nil
+}
+
+func (handler *MisbehaviorsStore) Get(key string) (*bridge_pb.MisbehaviorEvidence, error) {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	itemBytes, err := handler.backend.Get([]byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	if len(itemBytes) != 0 {
+		item := &bridge_pb.MisbehaviorEvidence{}
+		if err := proto.Unmarshal(itemBytes, item); err != nil {
+			return nil, fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return item, nil
+	}
+
+	return nil, nil
+}
+
+// Iterate calls fn for each evidence whose key starts with prefix (e.g. a validator address), in key order
+func (handler *MisbehaviorsStore) Iterate(prefix string, fn func(key string, evidence *bridge_pb.MisbehaviorEvidence) error) error {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	return handler.backend.Iterate([]byte(prefix), func(key []byte, value []byte) error {
+		item := &bridge_pb.MisbehaviorEvidence{}
+		if err := proto.Unmarshal(value, item); err != nil {
+			return fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return fn(string(key), item)
+	})
+}
@@ -0,0 +1,93 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// PendingTransactionsStore keeps the transactions submitted by the peers until the streamers observe them,
+// a transaction is then merged in the transactions store of its chain
+type PendingTransactionsStore struct {
+	backend Backend
+	rwmutex sync.RWMutex
+	sync.Mutex
+}
+
+// NewPendingTransactionsStore creates a new PendingTransactionsStore wrapping the provided backend
+func NewPendingTransactionsStore(backend Backend) *PendingTransactionsStore {
+	return &PendingTransactionsStore{backend: backend}
+}
+
+// PendingTransactionKey returns the key of a pending transaction, the peers submitting different hashes
+// for the same transaction are kept apart so that only the hash observed locally is merged
+func PendingTransactionKey(chain string, txKey string, hash string) string {
+	return fmt.Sprintf("%s-%s-%s", chain, txKey, hash)
+}
+
+func (handler *PendingTransactionsStore) Put(key string, pendingTx *bridge_pb.PendingTransaction) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	itemBytes, err := proto.Marshal(pendingTx)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrSerialization, err)
+	}
+
+	err = handler.backend.Put([]byte(key), itemBytes)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return nil
+}
+
+func (handler *PendingTransactionsStore) Get(key string) (*bridge_pb.PendingTransaction, error) {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	itemBytes, err := handler.backend.Get([]byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	if len(itemBytes) != 0 {
+		item := &bridge_pb.PendingTransaction{}
+		if err := proto.Unmarshal(itemBytes, item); err != nil {
+			return nil, fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return item, nil
+	}
+
+	return nil, nil
+}
+
+func (handler *PendingTransactionsStore) Delete(key string) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	err := handler.backend.Delete([]byte(key))
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return nil
+}
+
+// Iterate calls fn for each pending transaction, in key order
+func (handler *PendingTransactionsStore) Iterate(fn func(key string, pendingTx *bridge_pb.PendingTransaction) error) error {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	return handler.backend.Iterate(nil, func(key []byte, value []byte) error {
+		item := &bridge_pb.PendingTransaction{}
+		if err := proto.Unmarshal(value, item); err != nil {
+			return fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return fn(string(key), item)
+	})
+}
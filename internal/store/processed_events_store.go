@@ -0,0 +1,125 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ProcessedEventsStore records the bridge events already processed by the streamers
+type ProcessedEventsStore struct {
+	backend Backend
+	rwmutex sync.RWMutex
+	sync.Mutex
+
+	// locks of the events being processed, with the number of processors holding or waiting for each
+	eventLocks      map[string]*eventLock
+	eventLocksMutex sync.Mutex
+}
+
+type eventLock struct {
+	sync.Mutex
+	refs uint
+}
+
+// NewProcessedEventsStore creates a new ProcessedEventsStore wrapping the provided backend
+func NewProcessedEventsStore(backend Backend) *ProcessedEventsStore {
+	return &ProcessedEventsStore{backend: backend, eventLocks: make(map[string]*eventLock)}
+}
+
+// LockEvent waits until no other processor (streamer or verifier) processes the event key and returns the function
+// releasing it, so that checking if the event was processed, processing it and recording it is atomic
+func (handler *ProcessedEventsStore) LockEvent(key string) func() {
+	handler.eventLocksMutex.Lock()
+	lock, found := handler.eventLocks[key]
+	if !found {
+		lock = &eventLock{}
+		handler.eventLocks[key] = lock
+	}
+	lock.refs++
+	handler.eventLocksMutex.Unlock()
+
+	lock.Lock()
+
+	return func() {
+		lock.Unlock()
+
+		handler.eventLocksMutex.Lock()
+		lock.refs--
+		if lock.refs == 0 {
+			delete(handler.eventLocks, key)
+		}
+		handler.eventLocksMutex.Unlock()
+	}
+}
+
+// ProcessedEventKey returns the key of an event identified by its position on chain,
+// index is the log index for Ethereum and the event sequence for Koinos
+func ProcessedEventKey(chain string, blockNumber uint64, transactionId string, index uint64) string {
+	return fmt.Sprintf("%s%d", ProcessedTransactionEventsPrefix(chain, blockNumber, transactionId), index)
+}
+
+// ProcessedTransactionEventsPrefix returns the prefix of the keys of the events of a transaction
+func ProcessedTransactionEventsPrefix(chain string, blockNumber uint64, transactionId string) string {
+	return fmt.Sprintf("%s-%020d-%s-", chain, blockNumber, transactionId)
+}
+
+func (handler *ProcessedEventsStore) Put(key string, event *bridge_pb.ProcessedEvent) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	itemBytes, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrSerialization, err)
+	}
+
+	err = handler.backend.Put([]byte(key), itemBytes)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return nil
+}
+
+func (handler *ProcessedEventsStore) Get(key string) (*bridge_pb.ProcessedEvent, error) {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	itemBytes, err := handler.backend.Get([]byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	if len(itemBytes) != 0 {
+		item := &bridge_pb.ProcessedEvent{}
+		if err := proto.Unmarshal(itemBytes, item); err != nil {
+			return nil, fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return item, nil
+	}
+
+	return nil, nil
+}
+
+// Iterate calls fn for each processed event of the store, in key order
+func (handler *ProcessedEventsStore) Iterate(fn func(key string, event *bridge_pb.ProcessedEvent) error) error {
+	return handler.IteratePrefix("", fn)
+}
+
+// IteratePrefix calls fn for each processed event whose key starts with prefix, in key order
+func (handler *ProcessedEventsStore) IteratePrefix(prefix string, fn func(key string, event *bridge_pb.ProcessedEvent) error) error {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	return handler.backend.Iterate([]byte(prefix), func(key []byte, value []byte) error {
+		item := &bridge_pb.ProcessedEvent{}
+		if err := proto.Unmarshal(value, item); err != nil {
+			return fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return fn(string(key), item)
+	})
+}
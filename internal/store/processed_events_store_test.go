@@ -0,0 +1,55 @@
+package store
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+func TestProcessedEventsStoreLockEvent(t *testing.T) {
+	processedEventsStore := NewProcessedEventsStore(NewMapBackend())
+	key := ProcessedEventKey("ethereum", 10, "0x01", 2)
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	processed := 0
+
+	// the streamer and the verifier processing the same event concurrently
+	for worker := 0; worker < 8; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock := processedEventsStore.LockEvent(key)
+			defer unlock()
+
+			event, err := processedEventsStore.Get(key)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			if event == nil {
+				mutex.Lock()
+				processed++
+				mutex.Unlock()
+
+				err = processedEventsStore.Put(key, &bridge_pb.ProcessedEvent{Chain: "ethereum", BlockNumber: 10, TransactionId: "0x01", Index: 2})
+				if err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if processed != 1 {
+		t.Fatalf("expected the event to be processed once, got %d", processed)
+	}
+
+	if len(processedEventsStore.eventLocks) != 0 {
+		t.Fatalf("expected the event locks to be released, got %d", len(processedEventsStore.eventLocks))
+	}
+}
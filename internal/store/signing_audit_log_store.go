@@ -0,0 +1,184 @@
+package store
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrAuditLogTampered is returned when the hash chain of the signing audit log is broken
+var ErrAuditLogTampered = errors.New("signing audit log tampered")
+
+// SigningAuditLogStore is an append-only log of the signatures made by the validator,
+// each entry includes the hash of the previous one so that any modification breaks the chain
+type SigningAuditLogStore struct {
+	backend Backend
+	rwmutex sync.RWMutex
+	head    *bridge_pb.SigningAuditEntry
+}
+
+// NewSigningAuditLogStore creates a new SigningAuditLogStore wrapping the provided backend
+func NewSigningAuditLogStore(backend Backend) (*SigningAuditLogStore, error) {
+	auditLog := &SigningAuditLogStore{backend: backend}
+
+	err := auditLog.Iterate(func(entry *bridge_pb.SigningAuditEntry) error {
+		auditLog.head = entry
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return auditLog, nil
+}
+
+func signingAuditEntryKey(sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%020d", sequence))
+}
+
+// SigningAuditEntryHash returns the hash of an entry, computed over all its fields but entry_hash
+func SigningAuditEntryHash(entry *bridge_pb.SigningAuditEntry) ([]byte, error) {
+	unhashed := proto.Clone(entry).(*bridge_pb.SigningAuditEntry)
+	unhashed.EntryHash = nil
+
+	entryBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(unhashed)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrSerialization, err)
+	}
+
+	hash := sha256.Sum256(entryBytes)
+	return hash[:], nil
+}
+
+// Append chains entry to the last entry of the log and stores it
+func (handler *SigningAuditLogStore) Append(entry *bridge_pb.SigningAuditEntry) (*bridge_pb.SigningAuditEntry, error) {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	entry = proto.Clone(entry).(*bridge_pb.SigningAuditEntry)
+	entry.Sequence = 1
+	entry.PreviousEntryHash = nil
+	entry.Timestamp = uint64(time.Now().UnixMilli())
+
+	if handler.head != nil {
+		entry.Sequence = handler.head.Sequence + 1
+		entry.PreviousEntryHash = handler.head.EntryHash
+	}
+
+	entryHash, err := SigningAuditEntryHash(entry)
+	if err != nil {
+		return nil, err
+	}
+	entry.EntryHash = entryHash
+
+	return entry, handler.put(entry)
+}
+
+// Import appends an entry exported from another log, its chaining with the last entry is verified
+func (handler *SigningAuditLogStore) Import(entry *bridge_pb.SigningAuditEntry) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	err := verifySigningAuditEntry(handler.head, entry)
+	if err != nil {
+		return err
+	}
+
+	return handler.put(entry)
+}
+
+func (handler *SigningAuditLogStore) put(entry *bridge_pb.SigningAuditEntry) error {
+	itemBytes, err := proto.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrSerialization, err)
+	}
+
+	err = handler.backend.Put(signingAuditEntryKey(entry.Sequence), itemBytes)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	handler.head = entry
+
+	return nil
+}
+
+// Head returns the last entry of the log, nil if the log is empty
+func (handler *SigningAuditLogStore) Head() *bridge_pb.SigningAuditEntry {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	return handler.head
+}
+
+// Iterate calls fn for each entry of the log, in sequence order
+func (handler *SigningAuditLogStore) Iterate(fn func(entry *bridge_pb.SigningAuditEntry) error) error {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	return handler.backend.Iterate(nil, func(key []byte, value []byte) error {
+		item := &bridge_pb.SigningAuditEntry{}
+		if err := proto.Unmarshal(value, item); err != nil {
+			return fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return fn(item)
+	})
+}
+
+// Verify checks the hash chain of the whole log and calls fn for each entry verified,
+// it returns the number of entries verified
+func (handler *SigningAuditLogStore) Verify(fn func(entry *bridge_pb.SigningAuditEntry) error) (uint64, error) {
+	var previous *bridge_pb.SigningAuditEntry
+	count := uint64(0)
+
+	err := handler.Iterate(func(entry *bridge_pb.SigningAuditEntry) error {
+		err := verifySigningAuditEntry(previous, entry)
+		if err != nil {
+			return err
+		}
+
+		previous = entry
+		count++
+
+		return fn(entry)
+	})
+
+	return count, err
+}
+
+// verifySigningAuditEntry checks that entry is correctly hashed and follows previous, nil for the first entry
+func verifySigningAuditEntry(previous *bridge_pb.SigningAuditEntry, entry *bridge_pb.SigningAuditEntry) error {
+	expectedSequence := uint64(1)
+	var expectedPreviousHash []byte
+
+	if previous != nil {
+		expectedSequence = previous.Sequence + 1
+		expectedPreviousHash = previous.EntryHash
+	}
+
+	if entry.Sequence != expectedSequence {
+		return fmt.Errorf("%w, entry %d found where entry %d was expected", ErrAuditLogTampered, entry.Sequence, expectedSequence)
+	}
+
+	if !bytes.Equal(entry.PreviousEntryHash, expectedPreviousHash) {
+		return fmt.Errorf("%w, entry %d is not chained with entry %d", ErrAuditLogTampered, entry.Sequence, expectedSequence-1)
+	}
+
+	entryHash, err := SigningAuditEntryHash(entry)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(entry.EntryHash, entryHash) {
+		return fmt.Errorf("%w, hash of entry %d does not match its content", ErrAuditLogTampered, entry.Sequence)
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// newTestSigningAuditLog returns a log of 3 entries with its backend
+func newTestSigningAuditLog(t *testing.T) (*SigningAuditLogStore, *MapBackend) {
+	t.Helper()
+
+	backend := NewMapBackend()
+	auditLog, err := NewSigningAuditLogStore(backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for index := uint64(1); index <= 3; index++ {
+		_, err = auditLog.Append(&bridge_pb.SigningAuditEntry{Chain: "koinos", BlockNumber: index, Signer: "validator", Signature: []byte{byte(index)}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return auditLog, backend
+}
+
+func getTestSigningAuditEntry(t *testing.T, backend *MapBackend, sequence uint64) *bridge_pb.SigningAuditEntry {
+	t.Helper()
+
+	value, err := backend.Get(signingAuditEntryKey(sequence))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := &bridge_pb.SigningAuditEntry{}
+	if err = proto.Unmarshal(value, entry); err != nil {
+		t.Fatal(err)
+	}
+
+	return entry
+}
+
+func putTestSigningAuditEntry(t *testing.T, backend *MapBackend, key uint64, entry *bridge_pb.SigningAuditEntry) {
+	t.Helper()
+
+	value, err := proto.Marshal(entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = backend.Put(signingAuditEntryKey(key), value); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSigningAuditLogVerify(t *testing.T) {
+	auditLog, backend := newTestSigningAuditLog(t)
+
+	sequences := []uint64{}
+	count, err := auditLog.Verify(func(entry *bridge_pb.SigningAuditEntry) error {
+		sequences = append(sequences, entry.Sequence)
+		return nil
+	})
+	if err != nil || count != 3 {
+		t.Fatalf("expected 3 entries verified, got %d, %v", count, err)
+	}
+	for index, sequence := range sequences {
+		if sequence != uint64(index+1) {
+			t.Fatalf("expected the entries in sequence order, got %v", sequences)
+		}
+	}
+
+	// the head is restored when the log is reopened
+	reopened, err := NewSigningAuditLogStore(backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reopened.Head().Sequence != 3 || !proto.Equal(reopened.Head(), auditLog.Head()) {
+		t.Fatalf("expected the head to be entry 3, got %v", reopened.Head())
+	}
+
+	// the entries exported from the log can be imported into another log
+	imported, err := NewSigningAuditLogStore(NewMapBackend())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = auditLog.Iterate(func(entry *bridge_pb.SigningAuditEntry) error {
+		return imported.Import(entry)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count, err = imported.Verify(func(entry *bridge_pb.SigningAuditEntry) error { return nil }); err != nil || count != 3 {
+		t.Fatalf("expected the imported log to verify, got %d entries, %v", count, err)
+	}
+}
+
+func TestSigningAuditLogTampered(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(t *testing.T, backend *MapBackend)
+	}{
+		{"modified entry", func(t *testing.T, backend *MapBackend) {
+			entry := getTestSigningAuditEntry(t, backend, 2)
+			entry.Signer = "attacker"
+			putTestSigningAuditEntry(t, backend, 2, entry)
+		}},
+		{"removed middle entry", func(t *testing.T, backend *MapBackend) {
+			if err := backend.Delete(signingAuditEntryKey(2)); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"reordered entries", func(t *testing.T, backend *MapBackend) {
+			second := getTestSigningAuditEntry(t, backend, 2)
+			third := getTestSigningAuditEntry(t, backend, 3)
+			putTestSigningAuditEntry(t, backend, 2, third)
+			putTestSigningAuditEntry(t, backend, 3, second)
+		}},
+		{"reordered and renumbered entries", func(t *testing.T, backend *MapBackend) {
+			second := getTestSigningAuditEntry(t, backend, 2)
+			third := getTestSigningAuditEntry(t, backend, 3)
+			second.Sequence, third.Sequence = 3, 2
+			putTestSigningAuditEntry(t, backend, 2, third)
+			putTestSigningAuditEntry(t, backend, 3, second)
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			auditLog, backend := newTestSigningAuditLog(t)
+			test.tamper(t, backend)
+
+			_, err := auditLog.Verify(func(entry *bridge_pb.SigningAuditEntry) error { return nil })
+			if !errors.Is(err, ErrAuditLogTampered) {
+				t.Fatalf("expected ErrAuditLogTampered, got %v", err)
+			}
+		})
+	}
+}
+
+func TestSigningAuditLogImportTampered(t *testing.T) {
+	auditLog, backend := newTestSigningAuditLog(t)
+
+	imported, err := NewSigningAuditLogStore(NewMapBackend())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = imported.Import(getTestSigningAuditEntry(t, backend, 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	// an entry skipping the second one is not chained
+	err = imported.Import(getTestSigningAuditEntry(t, backend, 3))
+	if !errors.Is(err, ErrAuditLogTampered) {
+		t.Fatalf("expected ErrAuditLogTampered when skipping an entry, got %v", err)
+	}
+
+	// a modified entry does not match its hash
+	entry := getTestSigningAuditEntry(t, backend, 2)
+	entry.Signature = []byte{0xff}
+	err = imported.Import(entry)
+	if !errors.Is(err, ErrAuditLogTampered) {
+		t.Fatalf("expected ErrAuditLogTampered for a modified entry, got %v", err)
+	}
+
+	if imported.Head().Sequence != 1 || auditLog.Head().Sequence != 3 {
+		t.Fatal("expected the rejected entries not to be imported")
+	}
+}
@@ -0,0 +1,429 @@
+package store
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/koinos/koinos-log-golang"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+
+	// database/sql drivers
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	SQLiteDriver   = "sqlite3"
+	PostgresDriver = "postgres"
+)
+
+// sqlMigration is a schema migration, written for each supported driver
+type sqlMigration struct {
+	description string
+	sqlite      string
+	postgres    string
+}
+
+// sqlMigrations are applied in order, the version of a migration is its index + 1
+var sqlMigrations = []sqlMigration{
+	{
+		description: "create the key_values and transactions tables",
+		sqlite: `
+CREATE TABLE key_values (
+	store TEXT NOT NULL,
+	kv_key BLOB NOT NULL,
+	value BLOB NOT NULL,
+	PRIMARY KEY (store, kv_key)
+);
+
+CREATE TABLE transactions (
+	chain TEXT NOT NULL,
+	tx_key BLOB NOT NULL,
+	type TEXT NOT NULL,
+	id TEXT NOT NULL,
+	op_id TEXT NOT NULL,
+	block_number INTEGER NOT NULL,
+	status TEXT NOT NULL,
+	from_address TEXT NOT NULL,
+	recipient TEXT NOT NULL,
+	relayer TEXT NOT NULL,
+	eth_token TEXT NOT NULL,
+	koinos_token TEXT NOT NULL,
+	amount TEXT NOT NULL,
+	to_chain TEXT NOT NULL,
+	value BLOB NOT NULL,
+	PRIMARY KEY (chain, tx_key)
+);
+
+CREATE INDEX transactions_status_idx ON transactions (chain, status);
+CREATE INDEX transactions_from_address_idx ON transactions (from_address);
+CREATE INDEX transactions_recipient_idx ON transactions (recipient);
+`,
+		postgres: `
+CREATE TABLE key_values (
+	store TEXT NOT NULL,
+	kv_key BYTEA NOT NULL,
+	value BYTEA NOT NULL,
+	PRIMARY KEY (store, kv_key)
+);
+
+CREATE TABLE transactions (
+	chain TEXT NOT NULL,
+	tx_key BYTEA NOT NULL,
+	type TEXT NOT NULL,
+	id TEXT NOT NULL,
+	op_id TEXT NOT NULL,
+	block_number BIGINT NOT NULL,
+	status TEXT NOT NULL,
+	from_address TEXT NOT NULL,
+	recipient TEXT NOT NULL,
+	relayer TEXT NOT NULL,
+	eth_token TEXT NOT NULL,
+	koinos_token TEXT NOT NULL,
+	amount TEXT NOT NULL,
+	to_chain TEXT NOT NULL,
+	value BYTEA NOT NULL,
+	PRIMARY KEY (chain, tx_key)
+);
+
+CREATE INDEX transactions_status_idx ON transactions (chain, status);
+CREATE INDEX transactions_from_address_idx ON transactions (from_address);
+CREATE INDEX transactions_recipient_idx ON transactions (recipient);
+`,
+	},
+}
+
+// SQLDatabase is a SQLite or PostgreSQL database shared by the SQL backends
+type SQLDatabase struct {
+	DB     *sql.DB
+	driver string
+}
+
+// NewSQLDatabase opens the database and applies the missing schema migrations
+func NewSQLDatabase(driver string, dsn string) (*SQLDatabase, error) {
+	if driver != SQLiteDriver && driver != PostgresDriver {
+		return nil, fmt.Errorf("%w, unsupported sql driver %s", ErrBackend, driver)
+	}
+
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	if driver == SQLiteDriver {
+		// SQLite only supports a single writer
+		db.SetMaxOpenConns(1)
+	}
+
+	database := &SQLDatabase{DB: db, driver: driver}
+
+	err = database.migrate()
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return database, nil
+}
+
+// Close cleans database resources
+func (database *SQLDatabase) Close() {
+	database.DB.Close()
+}
+
+// Compact reclaims the space of the deleted rows
+func (database *SQLDatabase) Compact() error {
+	_, err := database.DB.Exec("VACUUM")
+	return err
+}
+
+// ReplaceStores replaces the rows of each store of names with the rows of the store it maps to, in a single transaction
+func (database *SQLDatabase) ReplaceStores(names map[string]string) error {
+	tx, err := database.DB.Begin()
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	for name, from := range names {
+		for _, statement := range []string{
+			"DELETE FROM key_values WHERE store = ?",
+			"UPDATE key_values SET store = ? WHERE store = ?",
+			"DELETE FROM transactions WHERE chain = ?",
+			"UPDATE transactions SET chain = ? WHERE chain = ?",
+		} {
+			args := []interface{}{name}
+			if strings.HasPrefix(statement, "UPDATE") {
+				args = append(args, from)
+			}
+
+			_, err = tx.Exec(database.rebind(statement), args...)
+			if err != nil {
+				tx.Rollback()
+				return fmt.Errorf("%w, %v", ErrBackend, err)
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return nil
+}
+
+// rebind replaces the ? placeholders with the placeholders of the driver
+func (database *SQLDatabase) rebind(query string) string {
+	if database.driver != PostgresDriver {
+		return query
+	}
+
+	var builder strings.Builder
+	index := 0
+	for _, c := range query {
+		if c == '?' {
+			index++
+			builder.WriteString("$" + strconv.Itoa(index))
+		} else {
+			builder.WriteRune(c)
+		}
+	}
+
+	return builder.String()
+}
+
+func (database *SQLDatabase) exec(query string, args ...interface{}) error {
+	_, err := database.DB.Exec(database.rebind(query), args...)
+	return err
+}
+
+func (database *SQLDatabase) migrate() error {
+	err := database.exec("CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER PRIMARY KEY, applied_at BIGINT NOT NULL)")
+	if err != nil {
+		return err
+	}
+
+	var version int
+	err = database.DB.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	for index := version; index < len(sqlMigrations); index++ {
+		migration := sqlMigrations[index]
+		log.Infof("applying database migration %d: %s", index+1, migration.description)
+
+		statements := migration.sqlite
+		if database.driver == PostgresDriver {
+			statements = migration.postgres
+		}
+
+		tx, err := database.DB.Begin()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(statements)
+		if err == nil {
+			_, err = tx.Exec(database.rebind("INSERT INTO schema_migrations (version, applied_at) VALUES (?, ?)"), index+1, time.Now().UnixMilli())
+		}
+
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d failed: %v", index+1, err)
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sqlIteratePageSize is the number of rows read at once by iterate
+const sqlIteratePageSize = 256
+
+// iterate calls fn in key order for each key/value pair of the table whose key starts with prefix,
+// query selects the key and value columns of owner and has a %s placeholder for the key comparison operator.
+// The rows are read one page at a time, before calling fn so that fn can write to the database
+func (database *SQLDatabase) iterate(prefix []byte, fn func(key []byte, value []byte) error, query string, owner string) error {
+	lastKey := append([]byte{}, prefix...)
+	operator := ">="
+
+	for {
+		keys, values, err := database.readPage(prefix, fmt.Sprintf(query, operator), owner, lastKey)
+		if err != nil {
+			return err
+		}
+
+		for index, key := range keys {
+			err = fn(key, values[index])
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(keys) < sqlIteratePageSize {
+			return nil
+		}
+
+		// the next page starts after the last key read
+		lastKey = keys[len(keys)-1]
+		operator = ">"
+	}
+}
+
+// readPage reads at most sqlIteratePageSize rows of owner from fromKey, it stops at the first key not starting with prefix
+func (database *SQLDatabase) readPage(prefix []byte, query string, owner string, fromKey []byte) ([][]byte, [][]byte, error) {
+	rows, err := database.DB.Query(database.rebind(query), owner, fromKey, sqlIteratePageSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	keys := [][]byte{}
+	values := [][]byte{}
+
+	for rows.Next() {
+		var key []byte
+		var value []byte
+		err = rows.Scan(&key, &value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !bytes.HasPrefix(key, prefix) {
+			// keys are ordered, no more key can match
+			return keys, values, nil
+		}
+
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	return keys, values, rows.Err()
+}
+
+// SQLBackend implements a key-value store in the key_values table of a SQL database
+type SQLBackend struct {
+	database *SQLDatabase
+	name     string
+}
+
+// NewSQLBackend creates a backend storing its key/value pairs under name
+func NewSQLBackend(database *SQLDatabase, name string) *SQLBackend {
+	return &SQLBackend{database: database, name: name}
+}
+
+// Reset resets the database
+func (backend *SQLBackend) Reset() error {
+	return backend.database.exec("DELETE FROM key_values WHERE store = ?", backend.name)
+}
+
+// Put backend setter
+func (backend *SQLBackend) Put(key []byte, value []byte) error {
+	if value == nil {
+		return errors.New("cannot put a nil value")
+	}
+
+	return backend.database.exec(
+		"INSERT INTO key_values (store, kv_key, value) VALUES (?, ?, ?) ON CONFLICT (store, kv_key) DO UPDATE SET value = excluded.value",
+		backend.name, key, value,
+	)
+}
+
+// Delete backend deleter
+func (backend *SQLBackend) Delete(key []byte) error {
+	return backend.database.exec("DELETE FROM key_values WHERE store = ? AND kv_key = ?", backend.name, key)
+}
+
+// Get backend getter
+func (backend *SQLBackend) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := backend.database.DB.QueryRow(backend.database.rebind("SELECT value FROM key_values WHERE store = ? AND kv_key = ?"), backend.name, key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return make([]byte, 0), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Iterate calls fn for each key/value pair whose key starts with prefix, in key order
+func (backend *SQLBackend) Iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	return backend.database.iterate(prefix, fn, "SELECT kv_key, value FROM key_values WHERE store = ? AND kv_key %s ? ORDER BY kv_key LIMIT ?", backend.name)
+}
+
+// SQLTransactionsBackend stores bridge transactions in the transactions table of a SQL database,
+// with their status, chain and addresses in their own columns
+type SQLTransactionsBackend struct {
+	database *SQLDatabase
+	chain    string
+}
+
+// NewSQLTransactionsBackend creates a backend storing the transactions of chain
+func NewSQLTransactionsBackend(database *SQLDatabase, chain string) *SQLTransactionsBackend {
+	return &SQLTransactionsBackend{database: database, chain: chain}
+}
+
+// Reset resets the database
+func (backend *SQLTransactionsBackend) Reset() error {
+	return backend.database.exec("DELETE FROM transactions WHERE chain = ?", backend.chain)
+}
+
+// Put backend setter, value must be a serialized bridge transaction
+func (backend *SQLTransactionsBackend) Put(key []byte, value []byte) error {
+	if value == nil {
+		return errors.New("cannot put a nil value")
+	}
+
+	tx := &bridge_pb.Transaction{}
+	if err := proto.Unmarshal(value, tx); err != nil {
+		return fmt.Errorf("%w, %v", ErrDeserialization, err)
+	}
+
+	return backend.database.exec(
+		`INSERT INTO transactions (chain, tx_key, type, id, op_id, block_number, status, from_address, recipient, relayer, eth_token, koinos_token, amount, to_chain, value)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (chain, tx_key) DO UPDATE SET
+			type = excluded.type, id = excluded.id, op_id = excluded.op_id, block_number = excluded.block_number,
+			status = excluded.status, from_address = excluded.from_address, recipient = excluded.recipient,
+			relayer = excluded.relayer, eth_token = excluded.eth_token, koinos_token = excluded.koinos_token,
+			amount = excluded.amount, to_chain = excluded.to_chain, value = excluded.value`,
+		backend.chain, key, tx.Type.String(), tx.Id, tx.OpId, int64(tx.BlockNumber), tx.Status.String(), tx.From, tx.Recipient,
+		tx.Relayer, tx.EthToken, tx.KoinosToken, tx.Amount, tx.ToChain, value,
+	)
+}
+
+// Delete backend deleter
+func (backend *SQLTransactionsBackend) Delete(key []byte) error {
+	return backend.database.exec("DELETE FROM transactions WHERE chain = ? AND tx_key = ?", backend.chain, key)
+}
+
+// Get backend getter
+func (backend *SQLTransactionsBackend) Get(key []byte) ([]byte, error) {
+	var value []byte
+	err := backend.database.DB.QueryRow(backend.database.rebind("SELECT value FROM transactions WHERE chain = ? AND tx_key = ?"), backend.chain, key).Scan(&value)
+	if err == sql.ErrNoRows {
+		return make([]byte, 0), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Iterate calls fn for each key/value pair whose key starts with prefix, in key order
+func (backend *SQLTransactionsBackend) Iterate(prefix []byte, fn func(key []byte, value []byte) error) error {
+	return backend.database.iterate(prefix, fn, "SELECT tx_key, value FROM transactions WHERE chain = ? AND tx_key %s ? ORDER BY tx_key LIMIT ?", backend.chain)
+}
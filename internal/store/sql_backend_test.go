@@ -0,0 +1,339 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+func newTestSQLiteDatabase(t *testing.T) (*SQLDatabase, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "bridge.db")
+	database, err := NewSQLDatabase(SQLiteDriver, path)
+	if err != nil {
+		t.Fatalf("cannot open the database: %v", err)
+	}
+	t.Cleanup(database.Close)
+
+	return database, path
+}
+
+func TestSQLDatabaseMigrations(t *testing.T) {
+	database, path := newTestSQLiteDatabase(t)
+
+	var version, count int
+	err := database.DB.QueryRow("SELECT MAX(version), COUNT(*) FROM schema_migrations").Scan(&version, &count)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != len(sqlMigrations) || count != len(sqlMigrations) {
+		t.Fatalf("expected %d applied migrations, got version %d and %d rows", len(sqlMigrations), version, count)
+	}
+
+	for _, table := range []string{"key_values", "transactions"} {
+		var name string
+		err = database.DB.QueryRow("SELECT name FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&name)
+		if err != nil {
+			t.Fatalf("table %s is missing: %v", table, err)
+		}
+	}
+
+	// reopening the database does not apply the migrations again
+	database.Close()
+	database, err = NewSQLDatabase(SQLiteDriver, path)
+	if err != nil {
+		t.Fatalf("cannot reopen the database: %v", err)
+	}
+	defer database.Close()
+
+	err = database.DB.QueryRow("SELECT COUNT(*) FROM schema_migrations").Scan(&count)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != len(sqlMigrations) {
+		t.Fatalf("expected %d migrations after reopening, got %d", len(sqlMigrations), count)
+	}
+}
+
+func TestSQLDatabaseUnsupportedDriver(t *testing.T) {
+	_, err := NewSQLDatabase("mysql", "")
+	if !errors.Is(err, ErrBackend) {
+		t.Fatalf("expected ErrBackend, got %v", err)
+	}
+}
+
+func TestSQLBackendGetPutDelete(t *testing.T) {
+	database, _ := newTestSQLiteDatabase(t)
+	backend := NewSQLBackend(database, "metadata")
+	other := NewSQLBackend(database, "other")
+
+	value, err := backend.Get([]byte("missing"))
+	if err != nil || value == nil || len(value) != 0 {
+		t.Fatalf("expected an empty value for a missing key, got %v, %v", value, err)
+	}
+
+	if err = backend.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err = backend.Put([]byte("key"), []byte("updated")); err != nil {
+		t.Fatal(err)
+	}
+
+	value, err = backend.Get([]byte("key"))
+	if err != nil || string(value) != "updated" {
+		t.Fatalf("expected updated, got %s, %v", value, err)
+	}
+
+	// the stores sharing the table do not see each other keys
+	value, err = other.Get([]byte("key"))
+	if err != nil || len(value) != 0 {
+		t.Fatalf("expected the key to be missing in another store, got %s, %v", value, err)
+	}
+
+	if err = backend.Put([]byte("nil"), nil); err == nil {
+		t.Fatal("expected an error when putting a nil value")
+	}
+
+	if err = backend.Delete([]byte("key")); err != nil {
+		t.Fatal(err)
+	}
+	if err = backend.Delete([]byte("key")); err != nil {
+		t.Fatalf("deleting a missing key must not fail: %v", err)
+	}
+
+	value, err = backend.Get([]byte("key"))
+	if err != nil || len(value) != 0 {
+		t.Fatalf("expected the key to be deleted, got %s, %v", value, err)
+	}
+
+	if err = other.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err = backend.Put([]byte("key"), []byte("value")); err != nil {
+		t.Fatal(err)
+	}
+	if err = backend.Reset(); err != nil {
+		t.Fatal(err)
+	}
+
+	value, _ = backend.Get([]byte("key"))
+	if len(value) != 0 {
+		t.Fatal("expected Reset to delete the keys of the store")
+	}
+	value, _ = other.Get([]byte("key"))
+	if string(value) != "value" {
+		t.Fatal("expected Reset to keep the keys of the other stores")
+	}
+}
+
+func TestSQLBackendIterate(t *testing.T) {
+	database, _ := newTestSQLiteDatabase(t)
+	backend := NewSQLBackend(database, "metadata")
+
+	// more keys than a page, inserted out of order
+	expected := []string{}
+	for index := sqlIteratePageSize*2 + 10; index >= 0; index-- {
+		key := fmt.Sprintf("b/%05d", index)
+		expected = append(expected, key)
+		if err := backend.Put([]byte(key), []byte(key)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sort.Strings(expected)
+
+	for _, key := range []string{"a/00001", "b", "c/00001", "b0"} {
+		if err := backend.Put([]byte(key), []byte(key)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	keys := []string{}
+	err := backend.Iterate([]byte("b/"), func(key []byte, value []byte) error {
+		if !bytes.Equal(key, value) {
+			t.Fatalf("unexpected value %s for key %s", value, key)
+		}
+		keys = append(keys, string(key))
+
+		// fn can write to the database while iterating
+		return backend.Put([]byte("c/"+string(key)), value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(keys) != len(expected) {
+		t.Fatalf("expected %d keys, got %d", len(expected), len(keys))
+	}
+	for index := range keys {
+		if keys[index] != expected[index] {
+			t.Fatalf("expected key %s at %d, got %s", expected[index], index, keys[index])
+		}
+	}
+
+	// an error stops the iteration
+	stop := errors.New("stop")
+	count := 0
+	err = backend.Iterate([]byte("b/"), func(key []byte, value []byte) error {
+		count++
+		if count == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || count != 3 {
+		t.Fatalf("expected the iteration to stop at the error, got %v after %d keys", err, count)
+	}
+
+	count = 0
+	err = backend.Iterate([]byte("d/"), func(key []byte, value []byte) error {
+		count++
+		return nil
+	})
+	if err != nil || count != 0 {
+		t.Fatalf("expected no key for a missing prefix, got %d, %v", count, err)
+	}
+}
+
+func TestSQLTransactionsBackendColumns(t *testing.T) {
+	database, _ := newTestSQLiteDatabase(t)
+	backend := NewSQLTransactionsBackend(database, "ethereum")
+
+	tx := &bridge_pb.Transaction{
+		Type:        bridge_pb.TransactionType_ethereum,
+		Id:          "0x01",
+		OpId:        "2",
+		BlockNumber: 42,
+		Status:      bridge_pb.TransactionStatus_gathering_signatures,
+		From:        "0xfrom",
+		Recipient:   "1recipient",
+		Relayer:     "0xrelayer",
+		EthToken:    "0xtoken",
+		KoinosToken: "1token",
+		Amount:      "1000",
+		ToChain:     "1",
+	}
+
+	value, err := proto.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = backend.Put([]byte("0x01-2"), value); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = backend.Put([]byte("invalid"), []byte{0xff, 0xff}); !errors.Is(err, ErrDeserialization) {
+		t.Fatalf("expected ErrDeserialization for an invalid transaction, got %v", err)
+	}
+
+	var txType, id, opID, status, from, recipient, relayer, ethToken, koinosToken, amount, toChain string
+	var blockNumber int64
+	err = database.DB.QueryRow(
+		`SELECT type, id, op_id, block_number, status, from_address, recipient, relayer, eth_token, koinos_token, amount, to_chain
+		FROM transactions WHERE chain = ? AND tx_key = ?`, "ethereum", []byte("0x01-2"),
+	).Scan(&txType, &id, &opID, &blockNumber, &status, &from, &recipient, &relayer, &ethToken, &koinosToken, &amount, &toChain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if txType != "ethereum" || id != "0x01" || opID != "2" || blockNumber != 42 || status != "gathering_signatures" ||
+		from != "0xfrom" || recipient != "1recipient" || relayer != "0xrelayer" || ethToken != "0xtoken" ||
+		koinosToken != "1token" || amount != "1000" || toChain != "1" {
+		t.Fatalf("unexpected columns %s %s %s %d %s %s %s %s %s %s %s %s", txType, id, opID, blockNumber, status, from, recipient, relayer, ethToken, koinosToken, amount, toChain)
+	}
+
+	// the columns follow the updates of the transaction
+	tx.Status = bridge_pb.TransactionStatus_completed
+	value, _ = proto.Marshal(tx)
+	if err = backend.Put([]byte("0x01-2"), value); err != nil {
+		t.Fatal(err)
+	}
+
+	var count int
+	err = database.DB.QueryRow("SELECT COUNT(*) FROM transactions WHERE chain = ? AND status = ?", "ethereum", "completed").Scan(&count)
+	if err != nil || count != 1 {
+		t.Fatalf("expected 1 completed transaction, got %d, %v", count, err)
+	}
+
+	stored, err := backend.Get([]byte("0x01-2"))
+	if err != nil || !bytes.Equal(stored, value) {
+		t.Fatalf("expected the stored transaction to be returned, got %v", err)
+	}
+
+	// the lookups by status and address use the indexes
+	for _, query := range []string{
+		"SELECT tx_key FROM transactions WHERE chain = 'ethereum' AND status = 'completed'",
+		"SELECT tx_key FROM transactions WHERE from_address = '0xfrom'",
+		"SELECT tx_key FROM transactions WHERE recipient = '1recipient'",
+	} {
+		rows, err := database.DB.Query("EXPLAIN QUERY PLAN " + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		plan := ""
+		for rows.Next() {
+			var selectID, order, from int
+			var detail string
+			if err = rows.Scan(&selectID, &order, &from, &detail); err != nil {
+				rows.Close()
+				t.Fatal(err)
+			}
+			plan += detail
+		}
+		rows.Close()
+
+		if !bytes.Contains([]byte(plan), []byte("INDEX")) {
+			t.Fatalf("expected %s to use an index, got plan %s", query, plan)
+		}
+	}
+
+	// the transactions of the other chains are kept apart
+	other := NewSQLTransactionsBackend(database, "koinos")
+	stored, _ = other.Get([]byte("0x01-2"))
+	if len(stored) != 0 {
+		t.Fatal("expected the transaction to be missing on another chain")
+	}
+
+	if err = backend.Delete([]byte("0x01-2")); err != nil {
+		t.Fatal(err)
+	}
+	stored, _ = backend.Get([]byte("0x01-2"))
+	if len(stored) != 0 {
+		t.Fatal("expected the transaction to be deleted")
+	}
+}
+
+func TestSQLDatabaseReplaceStores(t *testing.T) {
+	database, _ := newTestSQLiteDatabase(t)
+	backend := NewSQLBackend(database, "metadata")
+	restored := NewSQLBackend(database, "restored-metadata")
+
+	backend.Put([]byte("old"), []byte("old"))
+	backend.Put([]byte("key"), []byte("old"))
+	restored.Put([]byte("key"), []byte("restored"))
+
+	if err := database.ReplaceStores(map[string]string{"metadata": "restored-metadata"}); err != nil {
+		t.Fatal(err)
+	}
+
+	value, _ := backend.Get([]byte("key"))
+	if string(value) != "restored" {
+		t.Fatalf("expected the restored value, got %s", value)
+	}
+	value, _ = backend.Get([]byte("old"))
+	if len(value) != 0 {
+		t.Fatal("expected the replaced records to be deleted")
+	}
+	value, _ = restored.Get([]byte("key"))
+	if len(value) != 0 {
+		t.Fatal("expected the restored store to be moved")
+	}
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+const (
+	// a value log file is rewritten when at least half of its space can be reclaimed
+	valueLogGCDiscardRatio = 0.5
+)
+
+// StorageMaintainer garbage collects the value logs of the badger databases and reports the disk usage
+type StorageMaintainer struct {
+	backends     []*BadgerBackend
+	archiveStore *ArchiveStore
+
+	lastValueLogGC         uint64
+	valueLogFilesRewritten uint64
+	mutex                  sync.Mutex
+}
+
+// NewStorageMaintainer creates a new StorageMaintainer, archiveStore is nil when the retention is disabled
+func NewStorageMaintainer(backends []*BadgerBackend, archiveStore *ArchiveStore) *StorageMaintainer {
+	return &StorageMaintainer{
+		backends:     backends,
+		archiveStore: archiveStore,
+	}
+}
+
+// Run garbage collects the value logs every interval ms until ctx is done
+func (maintainer *StorageMaintainer) Run(wg *sync.WaitGroup, ctx context.Context, interval uint) {
+	defer wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("stop storage maintainer")
+			return
+
+		case <-time.After(time.Millisecond * time.Duration(interval)):
+			maintainer.RunValueLogGC()
+		}
+	}
+}
+
+// RunValueLogGC garbage collects the value logs of the databases, it returns the number of files rewritten
+func (maintainer *StorageMaintainer) RunValueLogGC() int {
+	count := 0
+
+	for _, backend := range maintainer.backends {
+		rewritten, err := backend.RunValueLogGC(valueLogGCDiscardRatio)
+		if err != nil {
+			log.Errorf("cannot garbage collect the value log of %s: %s", backend.DB.Opts().Dir, err.Error())
+		}
+		count += rewritten
+	}
+
+	maintainer.mutex.Lock()
+	maintainer.lastValueLogGC = uint64(time.Now().UnixMilli())
+	maintainer.valueLogFilesRewritten += uint64(count)
+	maintainer.mutex.Unlock()
+
+	if count > 0 {
+		log.Infof("value logs garbage collected, %d files rewritten", count)
+	}
+
+	return count
+}
+
+// Usage returns the disk usage of the databases and of the archive
+func (maintainer *StorageMaintainer) Usage() (*bridge_pb.DiskUsage, error) {
+	usage := &bridge_pb.DiskUsage{}
+
+	for _, backend := range maintainer.backends {
+		lsmSize, valueLogSize := backend.Size()
+
+		usage.Databases = append(usage.Databases, &bridge_pb.DatabaseUsage{
+			Name:         filepath.Base(backend.DB.Opts().Dir),
+			LsmSize:      lsmSize,
+			ValueLogSize: valueLogSize,
+		})
+	}
+
+	if maintainer.archiveStore != nil {
+		archiveSize, err := maintainer.archiveStore.Size()
+		if err != nil {
+			return nil, err
+		}
+		usage.ArchiveSize = archiveSize
+	}
+
+	maintainer.mutex.Lock()
+	usage.LastValueLogGc = maintainer.lastValueLogGC
+	usage.ValueLogFilesRewritten = maintainer.valueLogFilesRewritten
+	maintainer.mutex.Unlock()
+
+	return usage, nil
+}
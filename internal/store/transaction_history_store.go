@@ -0,0 +1,145 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// TransactionHistoryStore records the state transitions of the transactions, keyed by chain, transaction key and sequence
+type TransactionHistoryStore struct {
+	backend Backend
+	rwmutex sync.RWMutex
+	// last sequence used, the sequences are timestamps in ns made strictly increasing
+	sequence uint64
+}
+
+// NewTransactionHistoryStore creates a new TransactionHistoryStore wrapping the provided backend
+func NewTransactionHistoryStore(backend Backend) *TransactionHistoryStore {
+	return &TransactionHistoryStore{backend: backend}
+}
+
+// TransactionHistoryPrefix returns the prefix of the keys of the transitions of the transaction txKey of chain
+func TransactionHistoryPrefix(chain string, txKey string) string {
+	return fmt.Sprintf("%s/%s/", chain, txKey)
+}
+
+// NewTransactionTransition returns the transition from previous (nil if the transaction is created) to transaction,
+// caused by source, or nil if neither the status nor the validators changed
+func NewTransactionTransition(previous *bridge_pb.Transaction, transaction *bridge_pb.Transaction, source *bridge_pb.TransactionTransition) *bridge_pb.TransactionTransition {
+	transition := proto.Clone(source).(*bridge_pb.TransactionTransition)
+	transition.Status = transaction.Status
+
+	previousValidators := make(map[string]bool)
+	if previous == nil {
+		transition.Created = true
+	} else {
+		transition.PreviousStatus = previous.Status
+		for _, validator := range previous.Validators {
+			previousValidators[validator] = true
+		}
+	}
+
+	validators := make(map[string]bool)
+	for index, validator := range transaction.Validators {
+		validators[validator] = true
+
+		if !previousValidators[validator] {
+			transition.AddedValidators = append(transition.AddedValidators, validator)
+			transition.AddedSignatures = append(transition.AddedSignatures, transaction.Signatures[index])
+		}
+	}
+
+	if previous != nil {
+		for _, validator := range previous.Validators {
+			if !validators[validator] {
+				transition.RemovedValidators = append(transition.RemovedValidators, validator)
+			}
+		}
+	}
+
+	if !transition.Created && transition.PreviousStatus == transition.Status &&
+		len(transition.AddedValidators) == 0 && len(transition.RemovedValidators) == 0 {
+		return nil
+	}
+
+	return transition
+}
+
+// Append timestamps transition and stores it after the previous transitions of its transaction
+func (handler *TransactionHistoryStore) Append(transition *bridge_pb.TransactionTransition) error {
+	_, err := handler.append(transition)
+	return err
+}
+
+// append appends transition and returns its key
+func (handler *TransactionHistoryStore) append(transition *bridge_pb.TransactionTransition) (string, error) {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	now := time.Now()
+	transition.Timestamp = uint64(now.UnixMilli())
+
+	handler.sequence++
+	if sequence := uint64(now.UnixNano()); sequence > handler.sequence {
+		handler.sequence = sequence
+	}
+
+	key := fmt.Sprintf("%s%020d", TransactionHistoryPrefix(transition.Chain, transition.Key), handler.sequence)
+
+	return key, handler.put(key, transition)
+}
+
+// Put stores a transition exported from another history under its key
+func (handler *TransactionHistoryStore) Put(key string, transition *bridge_pb.TransactionTransition) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	return handler.put(key, transition)
+}
+
+func (handler *TransactionHistoryStore) put(key string, transition *bridge_pb.TransactionTransition) error {
+	itemBytes, err := proto.Marshal(transition)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrSerialization, err)
+	}
+
+	err = handler.backend.Put([]byte(key), itemBytes)
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return nil
+}
+
+// Delete deletes the transition key
+func (handler *TransactionHistoryStore) Delete(key string) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	err := handler.backend.Delete([]byte(key))
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return nil
+}
+
+// Iterate calls fn for each transition whose key starts with prefix, in key order
+// (TransactionHistoryPrefix for the transitions of a transaction in chronological order)
+func (handler *TransactionHistoryStore) Iterate(prefix string, fn func(key string, transition *bridge_pb.TransactionTransition) error) error {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	return handler.backend.Iterate([]byte(prefix), func(key []byte, value []byte) error {
+		item := &bridge_pb.TransactionTransition{}
+		if err := proto.Unmarshal(value, item); err != nil {
+			return fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return fn(string(key), item)
+	})
+}
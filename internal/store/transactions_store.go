@@ -1,10 +1,13 @@
 package store
 
 import (
+	"context"
 	"fmt"
 	"sync"
 
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/tracing"
 	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -13,6 +16,10 @@ type TransactionsStore struct {
 	backend Backend
 	rwmutex sync.RWMutex
 	sync.Mutex
+
+	// optional, records the transitions of the transactions updated, under chain
+	history *TransactionHistoryStore
+	chain   string
 }
 
 // NewTransactionsStore creates a new TransactionsStore wrapping the provided backend
@@ -20,10 +27,77 @@ func NewTransactionsStore(backend Backend) *TransactionsStore {
 	return &TransactionsStore{backend: backend}
 }
 
+// SetHistory records in history the transitions of the transactions of chain updated with Update
+func (handler *TransactionsStore) SetHistory(history *TransactionHistoryStore, chain string) {
+	handler.history = history
+	handler.chain = chain
+}
+
 func (handler *TransactionsStore) Put(key string, transaction *bridge_pb.Transaction) error {
 	handler.rwmutex.Lock()
 	defer handler.rwmutex.Unlock()
 
+	return handler.put(key, transaction)
+}
+
+// Update puts transaction and records its transition in the history, source tells the cause of the transition
+func (handler *TransactionsStore) Update(ctx context.Context, key string, transaction *bridge_pb.Transaction, source *bridge_pb.TransactionTransition) error {
+	_, span := tracing.Start(ctx, "TransactionsStore.Update",
+		attribute.String("chain", handler.chain),
+		attribute.String("key", key),
+		attribute.String("status", transaction.Status.String()),
+	)
+
+	defer span.End()
+
+	err := handler.update(key, transaction, source)
+	tracing.SetError(span, err)
+
+	return err
+}
+
+func (handler *TransactionsStore) update(key string, transaction *bridge_pb.Transaction, source *bridge_pb.TransactionTransition) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	if handler.history == nil {
+		return handler.put(key, transaction)
+	}
+
+	previous, err := handler.get(key)
+	if err != nil {
+		return err
+	}
+
+	transition := NewTransactionTransition(previous, transaction, source)
+	if transition == nil {
+		return handler.put(key, transaction)
+	}
+
+	transition.Chain = handler.chain
+	transition.Key = key
+
+	// the history is another database, the transition is recorded before the state so that a crash
+	// between the two writes never leaves a state change missing from the history
+	transitionKey, err := handler.history.append(transition)
+	if err != nil {
+		return err
+	}
+
+	err = handler.put(key, transaction)
+	if err != nil {
+		// the transition did not happen
+		if deleteErr := handler.history.Delete(transitionKey); deleteErr != nil {
+			return fmt.Errorf("%v, cannot remove its transition %s: %v", err, transitionKey, deleteErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (handler *TransactionsStore) put(key string, transaction *bridge_pb.Transaction) error {
 	itemBytes, err := proto.Marshal(transaction)
 	if err != nil {
 		return fmt.Errorf("%w, %v", ErrSerialization, err)
@@ -41,6 +115,10 @@ func (handler *TransactionsStore) Get(key string) (*bridge_pb.Transaction, error
 	handler.rwmutex.RLock()
 	defer handler.rwmutex.RUnlock()
 
+	return handler.get(key)
+}
+
+func (handler *TransactionsStore) get(key string) (*bridge_pb.Transaction, error) {
 	itemBytes, err := handler.backend.Get([]byte(key))
 	if err != nil {
 		return nil, fmt.Errorf("%w, %v", ErrBackend, err)
@@ -57,3 +135,35 @@ func (handler *TransactionsStore) Get(key string) (*bridge_pb.Transaction, error
 
 	return nil, nil
 }
+
+func (handler *TransactionsStore) Delete(key string) error {
+	handler.rwmutex.Lock()
+	defer handler.rwmutex.Unlock()
+
+	err := handler.backend.Delete([]byte(key))
+	if err != nil {
+		return fmt.Errorf("%w, %v", ErrBackend, err)
+	}
+
+	return nil
+}
+
+// Iterate calls fn for every transaction in the store, in key order
+func (handler *TransactionsStore) Iterate(fn func(key string, transaction *bridge_pb.Transaction) error) error {
+	return handler.IteratePrefix("", fn)
+}
+
+// IteratePrefix calls fn for every transaction whose key starts with prefix, in key order
+func (handler *TransactionsStore) IteratePrefix(prefix string, fn func(key string, transaction *bridge_pb.Transaction) error) error {
+	handler.rwmutex.RLock()
+	defer handler.rwmutex.RUnlock()
+
+	return handler.backend.Iterate([]byte(prefix), func(key []byte, value []byte) error {
+		item := &bridge_pb.Transaction{}
+		if err := proto.Unmarshal(value, item); err != nil {
+			return fmt.Errorf("%w, %v", ErrDeserialization, err)
+		}
+
+		return fn(string(key), item)
+	})
+}
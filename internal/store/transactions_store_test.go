@@ -0,0 +1,86 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// failingBackend fails the puts once fail is set
+type failingBackend struct {
+	*MapBackend
+	fail bool
+}
+
+func (backend *failingBackend) Put(key []byte, value []byte) error {
+	if backend.fail {
+		return errors.New("disk full")
+	}
+
+	return backend.MapBackend.Put(key, value)
+}
+
+func countTransitions(t *testing.T, history *TransactionHistoryStore, key string) int {
+	t.Helper()
+
+	count := 0
+	err := history.backend.Iterate([]byte(TransactionHistoryPrefix("koinos", key)), func(key []byte, value []byte) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return count
+}
+
+func TestTransactionsStoreUpdateRecordsTransitions(t *testing.T) {
+	backend := &failingBackend{MapBackend: NewMapBackend()}
+	history := NewTransactionHistoryStore(NewMapBackend())
+	txStore := NewTransactionsStore(backend)
+	txStore.SetHistory(history, "koinos")
+
+	source := &bridge_pb.TransactionTransition{Cause: bridge_pb.TransitionCause_streamer_event}
+	tx := &bridge_pb.Transaction{Id: "0x01", Status: bridge_pb.TransactionStatus_gathering_signatures}
+
+	if err := txStore.Update(context.Background(), "0x01-1", tx, source); err != nil {
+		t.Fatal(err)
+	}
+	if count := countTransitions(t, history, "0x01-1"); count != 1 {
+		t.Fatalf("expected the creation to be recorded, got %d transitions", count)
+	}
+
+	// no transition without a change
+	if err := txStore.Update(context.Background(), "0x01-1", tx, source); err != nil {
+		t.Fatal(err)
+	}
+	if count := countTransitions(t, history, "0x01-1"); count != 1 {
+		t.Fatalf("expected no transition without a change, got %d transitions", count)
+	}
+
+	// the transition of a state that cannot be written is removed
+	backend.fail = true
+	tx.Status = bridge_pb.TransactionStatus_signed
+	if err := txStore.Update(context.Background(), "0x01-1", tx, source); err == nil {
+		t.Fatal("expected the update to fail")
+	}
+	if count := countTransitions(t, history, "0x01-1"); count != 1 {
+		t.Fatalf("expected the transition of the failed update to be removed, got %d transitions", count)
+	}
+
+	stored, err := txStore.Get("0x01-1")
+	if err != nil || stored.Status != bridge_pb.TransactionStatus_gathering_signatures {
+		t.Fatalf("expected the previous state to be kept, got %v, %v", stored, err)
+	}
+
+	backend.fail = false
+	if err := txStore.Update(context.Background(), "0x01-1", tx, source); err != nil {
+		t.Fatal(err)
+	}
+	if count := countTransitions(t, history, "0x01-1"); count != 2 {
+		t.Fatalf("expected the status change to be recorded, got %d transitions", count)
+	}
+}
@@ -0,0 +1,140 @@
+package streamer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// Archiver moves the completed transactions older than the retention, with their history, from the transactions
+// stores to the archive
+type Archiver struct {
+	evmChains     EvmChains
+	koinosTxStore *store.TransactionsStore
+	historyStore  *store.TransactionHistoryStore
+	archiveStore  *store.ArchiveStore
+	// retention in ms of the completed transactions, from their block time
+	retention uint64
+}
+
+// NewArchiver creates a new Archiver keeping the completed transactions retentionDays days
+func NewArchiver(evmChains EvmChains, koinosTxStore *store.TransactionsStore, historyStore *store.TransactionHistoryStore, archiveStore *store.ArchiveStore, retentionDays uint) *Archiver {
+	return &Archiver{
+		evmChains:     evmChains,
+		koinosTxStore: koinosTxStore,
+		historyStore:  historyStore,
+		archiveStore:  archiveStore,
+		retention:     uint64(retentionDays) * 24 * 60 * 60 * 1000,
+	}
+}
+
+// Run archives the transactions every interval ms until ctx is done
+func (archiver *Archiver) Run(wg *sync.WaitGroup, ctx context.Context, interval uint) {
+	defer wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("stop archiver")
+			return
+
+		case <-time.After(time.Millisecond * time.Duration(interval)):
+			archiver.Archive()
+		}
+	}
+}
+
+// Archive archives the completed transactions older than the retention and prunes them, it returns the number of
+// transactions archived
+func (archiver *Archiver) Archive() int {
+	now := uint64(time.Now().UnixMilli())
+	if now < archiver.retention {
+		return 0
+	}
+	before := now - archiver.retention
+
+	count := 0
+
+	for _, chain := range archiver.evmChains {
+		archived, err := archiver.archiveTransactions(chain.Name, chain.TxStore, before)
+		if err != nil {
+			log.Errorf("cannot archive %s transactions: %s", chain.Name, err.Error())
+		}
+		count += archived
+	}
+
+	archived, err := archiver.archiveTransactions(ChainKoinos, archiver.koinosTxStore, before)
+	if err != nil {
+		log.Errorf("cannot archive Koinos transactions: %s", err.Error())
+	}
+	count += archived
+
+	if count > 0 {
+		log.Infof("%d transactions archived", count)
+	}
+
+	return count
+}
+
+// archiveTransactions archives the completed transactions of txStore whose block time is before the given time,
+// they are only pruned once written to the archive
+func (archiver *Archiver) archiveTransactions(chain string, txStore *store.TransactionsStore, before uint64) (int, error) {
+	txStore.Lock()
+	defer txStore.Unlock()
+
+	archivedTxs := []*bridge_pb.ArchivedTransaction{}
+
+	// the transactions completed before being observed have no block time, they are kept until observed
+	err := txStore.Iterate(func(key string, tx *bridge_pb.Transaction) error {
+		if tx.Status == bridge_pb.TransactionStatus_completed && tx.BlockTime != 0 && tx.BlockTime < before {
+			archivedTxs = append(archivedTxs, &bridge_pb.ArchivedTransaction{
+				Chain:       chain,
+				Key:         key,
+				Transaction: tx,
+			})
+		}
+
+		return nil
+	})
+	if err != nil || len(archivedTxs) == 0 {
+		return 0, err
+	}
+
+	historyKeys := []string{}
+	for _, archivedTx := range archivedTxs {
+		err = archiver.historyStore.Iterate(store.TransactionHistoryPrefix(chain, archivedTx.Key), func(key string, transition *bridge_pb.TransactionTransition) error {
+			archivedTx.Transitions = append(archivedTx.Transitions, transition)
+			historyKeys = append(historyKeys, key)
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = archiver.archiveStore.Archive(chain, archivedTxs)
+	if err != nil {
+		return 0, err
+	}
+
+	for index, archivedTx := range archivedTxs {
+		err = txStore.Delete(archivedTx.Key)
+		if err != nil {
+			return index, err
+		}
+	}
+
+	for _, key := range historyKeys {
+		err = archiver.historyStore.Delete(key)
+		if err != nil {
+			return len(archivedTxs), err
+		}
+	}
+
+	return len(archivedTxs), nil
+}
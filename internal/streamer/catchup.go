@@ -0,0 +1,222 @@
+package streamer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/koinos/koinos-log-golang"
+	"github.com/koinos/koinos-proto-golang/koinos/rpc/block_store"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/rpc"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+)
+
+type catchUpJob struct {
+	fromBlock uint64
+	toBlock   uint64
+	result    interface{}
+	err       error
+	done      chan struct{}
+}
+
+// catchUp fetches the blocks from fromBlock to toBlock by ranges (rangeEnd returns the last block of the range starting
+// at a block) with up to workers concurrent fetches, and processes the ranges fetched in strict block order.
+// It stops at the first error, the ranges processed before stay processed.
+func catchUp(
+	ctx context.Context,
+	workers uint,
+	fromBlock uint64,
+	toBlock uint64,
+	rangeEnd func(fromBlock uint64) uint64,
+	fetch func(ctx context.Context, fromBlock uint64, toBlock uint64) (interface{}, error),
+	process func(fromBlock uint64, toBlock uint64, result interface{}) error,
+) error {
+	if workers == 0 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// a worker is released once its range is processed, so that at most workers ranges are held in memory
+	slots := make(chan struct{}, workers)
+	jobs := make(chan *catchUpJob, workers)
+
+	go func() {
+		defer close(jobs)
+
+		for from := fromBlock; from <= toBlock; {
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+
+			to := rangeEnd(from)
+			if to > toBlock || to < from {
+				to = toBlock
+			}
+
+			job := &catchUpJob{fromBlock: from, toBlock: to, done: make(chan struct{})}
+			go func() {
+				defer close(job.done)
+				job.result, job.err = fetch(ctx, job.fromBlock, job.toBlock)
+			}()
+
+			jobs <- job
+			from = to + 1
+		}
+	}()
+
+	for job := range jobs {
+		<-job.done
+
+		if job.err != nil {
+			return job.err
+		}
+
+		err := process(job.fromBlock, job.toBlock, job.result)
+		if err != nil {
+			return err
+		}
+
+		<-slots
+	}
+
+	return ctx.Err()
+}
+
+// catchUpEthereumBlocks processes the bridge events of an EVM chain from fromBlock, fetching the logs in parallel
+// and without polling delay, until the chain is within its catch up distance of head. It returns the last block parsed.
+func catchUpEthereumBlocks(
+	ctx context.Context,
+	ethCl *EvmClient,
+	chain *EvmChain,
+	processor *ethereumEventsProcessor,
+	metadataStore *store.MetadataStore,
+	fromBlock uint64,
+	workers uint,
+) (uint64, error) {
+	lastBlockParsed := fromBlock - 1
+
+	for {
+		latestblock, err := ethCl.LastFinalBlock(ctx)
+		if err != nil {
+			return lastBlockParsed, err
+		}
+
+		if latestblock <= lastBlockParsed || latestblock-lastBlockParsed <= chain.CatchUpDistance {
+			log.Infof("%s caught up: %d", chain.Name, lastBlockParsed)
+			return lastBlockParsed, nil
+		}
+
+		log.Infof("catching up %s logs: %d - %d", chain.Name, lastBlockParsed+1, latestblock)
+
+		err = catchUp(
+			ctx,
+			workers,
+			lastBlockParsed+1,
+			latestblock,
+			func(fromBlock uint64) uint64 {
+				return fromBlock + chain.LogsWindow.Size()
+			},
+			func(ctx context.Context, fromBlock uint64, toBlock uint64) (interface{}, error) {
+				// the provider may accept a smaller range than requested
+				logs := []types.Log{}
+				for fromBlock <= toBlock {
+					rangeLogs, rangeToBlock, err := filterLogs(ctx, ethCl, chain, fromBlock, toBlock)
+					if err != nil {
+						return nil, err
+					}
+
+					logs = append(logs, rangeLogs...)
+					fromBlock = rangeToBlock + 1
+				}
+
+				return logs, nil
+			},
+			func(fromBlock uint64, toBlock uint64, result interface{}) error {
+				for _, vLog := range result.([]types.Log) {
+					processor.processLog(vLog)
+				}
+
+				lastBlockParsed = toBlock
+				saveEvmLastBlockParsed(metadataStore, chain.Name, lastBlockParsed)
+
+				log.Infof("fetched %s logs: %d - %d", chain.Name, fromBlock, toBlock)
+				return nil
+			},
+		)
+		if err != nil {
+			return lastBlockParsed, err
+		}
+	}
+}
+
+// catchUpKoinosBlocks processes the Koinos blocks from fromBlock, fetching them in parallel and without polling delay,
+// until the last irreversible block is within catchUpDistance. It returns the last block parsed.
+func catchUpKoinosBlocks(
+	ctx context.Context,
+	rpcClient *rpc.JsonRPC,
+	processor *koinosEventsProcessor,
+	metadataStore *store.MetadataStore,
+	fromBlock uint64,
+	koinosMaxBlocksToStream uint64,
+	catchUpDistance uint64,
+	workers uint,
+) (uint64, error) {
+	lastBlockParsed := fromBlock - 1
+
+	for {
+		headInfo, err := rpcClient.GetHeadInfo(ctx)
+		if err != nil {
+			return lastBlockParsed, err
+		}
+
+		lastIrreversibleBlock := headInfo.LastIrreversibleBlock
+		if lastIrreversibleBlock <= lastBlockParsed || lastIrreversibleBlock-lastBlockParsed <= catchUpDistance {
+			log.Infof("koinos caught up: %d", lastBlockParsed)
+			return lastBlockParsed, nil
+		}
+
+		log.Infof("catching up koinos blocks: %d - %d", lastBlockParsed+1, lastIrreversibleBlock)
+
+		err = catchUp(
+			ctx,
+			workers,
+			lastBlockParsed+1,
+			lastIrreversibleBlock,
+			func(fromBlock uint64) uint64 {
+				return fromBlock + koinosMaxBlocksToStream - 1
+			},
+			func(ctx context.Context, fromBlock uint64, toBlock uint64) (interface{}, error) {
+				// the blocks are irreversible, any topology leads to the same blocks
+				blocks, err := rpcClient.GetBlocksByHeight(ctx, headInfo.HeadTopology.Id, fromBlock, uint32(toBlock-fromBlock+1))
+				if err != nil {
+					return nil, err
+				}
+
+				if uint64(len(blocks.BlockItems)) != toBlock-fromBlock+1 {
+					return nil, fmt.Errorf("koinos blocks %d - %d: %d blocks returned", fromBlock, toBlock, len(blocks.BlockItems))
+				}
+
+				return blocks.BlockItems, nil
+			},
+			func(fromBlock uint64, toBlock uint64, result interface{}) error {
+				for _, block := range result.([]*block_store.BlockItem) {
+					processor.processBlock(block, nil)
+				}
+
+				lastBlockParsed = toBlock
+				saveKoinosLastBlockParsed(metadataStore, lastBlockParsed)
+
+				log.Infof("fetched koinos blocks: %d - %d", fromBlock, toBlock)
+				return nil
+			},
+		)
+		if err != nil {
+			return lastBlockParsed, err
+		}
+	}
+}
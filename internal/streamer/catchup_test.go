@@ -0,0 +1,191 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mr-tron/base58"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+func TestCatchUpProcessesInOrder(t *testing.T) {
+	var mutex sync.Mutex
+	fetched := []uint64{}
+	processed := []uint64{}
+	checkpoint := uint64(0)
+	running := 0
+	maxRunning := 0
+
+	err := catchUp(
+		context.Background(),
+		4,
+		1,
+		100,
+		func(fromBlock uint64) uint64 {
+			return fromBlock + 9
+		},
+		func(ctx context.Context, fromBlock uint64, toBlock uint64) (interface{}, error) {
+			mutex.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mutex.Unlock()
+
+			// the later ranges of each batch of workers are fetched first
+			time.Sleep(time.Duration(40-(fromBlock/10%4)*10) * time.Millisecond)
+
+			mutex.Lock()
+			running--
+			fetched = append(fetched, fromBlock)
+			mutex.Unlock()
+
+			return fromBlock, nil
+		},
+		func(fromBlock uint64, toBlock uint64, result interface{}) error {
+			if result.(uint64) != fromBlock || fromBlock != checkpoint+1 {
+				t.Fatalf("expected the range from %d to be processed, got %d - %d", checkpoint+1, fromBlock, toBlock)
+			}
+
+			processed = append(processed, fromBlock)
+			checkpoint = toBlock
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if checkpoint != 100 || len(processed) != 10 {
+		t.Fatalf("expected the 10 ranges to be processed up to block 100, got %d ranges up to %d", len(processed), checkpoint)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	inOrder := true
+	for index := 1; index < len(fetched); index++ {
+		if fetched[index] < fetched[index-1] {
+			inOrder = false
+		}
+	}
+	if inOrder {
+		t.Fatalf("expected the workers to finish out of order, got %v", fetched)
+	}
+	if maxRunning > 4 || maxRunning < 2 {
+		t.Fatalf("expected up to 4 concurrent fetches, got %d", maxRunning)
+	}
+}
+
+func TestCatchUpEthereumBlocks(t *testing.T) {
+	logs := []types.Log{}
+	for _, blockNumber := range []uint64{15, 250, 260, 610, 990} {
+		vLog := testTokensLockedLog(t, blockNumber)
+		vLog.TxHash = common.BigToHash(new(big.Int).SetUint64(blockNumber))
+		logs = append(logs, vLog)
+	}
+
+	// the ranges of the first blocks are the slowest to fetch
+	rpc := &fakeEvmRPC{head: 1000, logs: logs, logsDelay: func(fromBlock uint64) time.Duration {
+		if fromBlock < 400 {
+			return time.Duration(400-fromBlock) * time.Millisecond / 10
+		}
+		return 0
+	}}
+	server := httptest.NewServer(rpc)
+	defer server.Close()
+
+	chain := newTestEvmChain(server.URL, FinalityConfirmations, 0)
+	chain.CatchUpDistance = 50
+
+	ethCl, err := DialEvmChain(context.Background(), chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ethCl.Close()
+
+	metadataStore := store.NewMetadataStore(store.NewMapBackend())
+	signingAuditLog, err := store.NewSigningAuditLogStore(store.NewMapBackend())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	processor, err := newEthereumEventsProcessor(
+		chain,
+		testKoinosPK,
+		"validator",
+		base58.Encode(bytes.Repeat([]byte{4}, 25)),
+		store.NewTransactionsStore(store.NewMapBackend()),
+		store.NewProcessedEventsStore(store.NewMapBackend()),
+		store.NewPendingTransactionsStore(store.NewMapBackend()),
+		signingAuditLog,
+		60000,
+		map[string]util.ValidatorConfig{},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lastBlockParsed, err := catchUpEthereumBlocks(context.Background(), ethCl, chain, processor, metadataStore, 1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// caught up to the final block, the streamer switches to polling
+	if lastBlockParsed != 1000 {
+		t.Fatalf("expected to catch up to block 1000, got %d", lastBlockParsed)
+	}
+
+	metadata, err := metadataStore.Get()
+	if err != nil || GetEvmLastBlockParsed(metadata, chain.Name) != 1000 {
+		t.Fatalf("expected the checkpoint at block 1000, got %v", err)
+	}
+
+	// the events are processed in block order, an event may be signed more than once
+	blocks := []uint64{}
+	err = signingAuditLog.Iterate(func(entry *bridge_pb.SigningAuditEntry) error {
+		if len(blocks) == 0 || blocks[len(blocks)-1] != entry.BlockNumber {
+			blocks = append(blocks, entry.BlockNumber)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(blocks) != len(logs) {
+		t.Fatalf("expected %d events to be processed, got %d", len(logs), len(blocks))
+	}
+	for index, vLog := range logs {
+		if blocks[index] != vLog.BlockNumber {
+			t.Fatalf("expected the events to be processed in block order, got blocks %v", blocks)
+		}
+	}
+
+	// within the catch up distance of head, nothing is fetched
+	rpc.mutex.Lock()
+	rpc.head = 1040
+	requests := len(rpc.ranges)
+	rpc.mutex.Unlock()
+
+	lastBlockParsed, err = catchUpEthereumBlocks(context.Background(), ethCl, chain, processor, metadataStore, 1001, 4)
+	if err != nil || lastBlockParsed != 1000 {
+		t.Fatalf("expected to switch to polling at block 1000, got %d, %v", lastBlockParsed, err)
+	}
+
+	rpc.mutex.Lock()
+	defer rpc.mutex.Unlock()
+	if len(rpc.ranges) != requests {
+		t.Fatal("expected no logs to be fetched within the catch up distance")
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"math/big"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -16,218 +15,390 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/ethereum/go-ethereum/ethclient"
 	log "github.com/koinos/koinos-log-golang"
 
 	"github.com/mr-tron/base58"
 
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/tracing"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
 	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
 
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 )
 
-func StreamEthereumBlocks(
-	wg *sync.WaitGroup,
-	ctx context.Context,
-	metadataStore *store.MetadataStore,
-	startBlock uint64,
-	ethRPC string,
-	ethContractStr string,
-	ethMaxBlocksToStream uint64,
+var (
+	tokensLockedEventTopic         = crypto.Keccak256Hash([]byte("TokensLockedEvent(address,address,uint256,uint256,string,string,string,uint256,uint32)"))
+	transferCompletedEventTopic    = crypto.Keccak256Hash([]byte("TransferCompletedEvent(bytes,uint256)"))
+	requestNewSignaturesEventTopic = crypto.Keccak256Hash([]byte("RequestNewSignaturesEvent(bytes,uint256)"))
+)
+
+const (
+	tokensLockedEventAbiStr = `[{
+	"anonymous": false,
+	"inputs": [
+	  {
+		"indexed": false,
+		"internalType": "address",
+		"name": "from",
+		"type": "address"
+	  },
+	  {
+		"indexed": false,
+		"internalType": "address",
+		"name": "token",
+		"type": "address"
+	  },
+	  {
+		"indexed": false,
+		"internalType": "uint256",
+		"name": "amount",
+		"type": "uint256"
+	  },
+		{
+		"indexed": false,
+		"internalType": "uint256",
+		"name": "payment",
+		"type": "uint256"
+	  },
+		{
+		"indexed": false,
+		"internalType": "string",
+		"name": "relayer",
+		"type": "string"
+	  },
+	  {
+		"indexed": false,
+		"internalType": "string",
+		"name": "recipient",
+		"type": "string"
+	  },
+		{
+		"indexed": false,
+		"internalType": "string",
+		"name": "metadata",
+		"type": "string"
+	  },
+	  {
+		"indexed": false,
+		"internalType": "uint256",
+		"name": "blocktime",
+		"type": "uint256"
+	  },
+		{
+			"indexed": false,
+			"internalType": "uint32",
+			"name": "chain",
+			"type": "uint32"
+		}
+	],
+	"name": "TokensLockedEvent",
+	"type": "event"
+  }]`
+
+	transferCompletedEventAbiStr = `[{
+	"anonymous": false,
+	"inputs": [
+	  {
+		"indexed": false,
+		"internalType": "bytes",
+		"name": "txId",
+		"type": "bytes"
+	  },
+	  {
+		"indexed": false,
+		"internalType": "uint256",
+		"name": "operationId",
+		"type": "uint256"
+	  }
+	],
+	"name": "TransferCompletedEvent",
+	"type": "event"
+  }]`
+
+	requestNewSignaturesEventAbiStr = `[{
+	"anonymous": false,
+	"inputs": [
+	  {
+		"indexed": false,
+		"internalType": "bytes",
+		"name": "txId",
+		"type": "bytes"
+	  },
+	  {
+		"indexed": false,
+		"internalType": "uint256",
+		"name": "blocktime",
+		"type": "uint256"
+	  }
+	],
+	"name": "RequestNewSignaturesEvent",
+	"type": "event"
+  }]`
+)
+
+// ethereumEventsProcessor processes the events emitted by the bridge contract of an EVM chain
+type ethereumEventsProcessor struct {
+	chain                *EvmChain
+	koinosPK             []byte
+	koinosAddress        string
+	koinosContractAddr   []byte
+	koinosTxStore        *store.TransactionsStore
+	processedEventsStore *store.ProcessedEventsStore
+	pendingTxStore       *store.PendingTransactionsStore
+	signingAuditLog      *store.SigningAuditLogStore
+	signaturesExpiration uint
+	validators           map[string]util.ValidatorConfig
+
+	tokensLockedEventAbi         abi.ABI
+	transferCompletedEventAbi    abi.ABI
+	requestNewSignaturesEventAbi abi.ABI
+}
+
+func newEthereumEventsProcessor(
+	chain *EvmChain,
 	koinosPK []byte,
 	koinosAddress string,
 	koinosContractStr string,
-	tokenAddresses map[string]util.TokenConfig,
-	ethTxStore *store.TransactionsStore,
 	koinosTxStore *store.TransactionsStore,
+	processedEventsStore *store.ProcessedEventsStore,
+	pendingTxStore *store.PendingTransactionsStore,
+	signingAuditLog *store.SigningAuditLogStore,
 	signaturesExpiration uint,
 	validators map[string]util.ValidatorConfig,
-	ethConfirmations uint64,
-	ethPollingTime uint,
-) {
-	defer wg.Done()
-	tokensLockedEventTopic := crypto.Keccak256Hash([]byte("TokensLockedEvent(address,address,uint256,uint256,string,string,string,uint256,uint32)"))
-	tokensLockedEventAbiStr := `[{
-		"anonymous": false,
-		"inputs": [
-		  {
-			"indexed": false,
-			"internalType": "address",
-			"name": "from",
-			"type": "address"
-		  },
-		  {
-			"indexed": false,
-			"internalType": "address",
-			"name": "token",
-			"type": "address"
-		  },
-		  {
-			"indexed": false,
-			"internalType": "uint256",
-			"name": "amount",
-			"type": "uint256"
-		  },
-			{
-			"indexed": false,
-			"internalType": "uint256",
-			"name": "payment",
-			"type": "uint256"
-		  },
-			{
-			"indexed": false,
-			"internalType": "string",
-			"name": "relayer",
-			"type": "string"
-		  },
-		  {
-			"indexed": false,
-			"internalType": "string",
-			"name": "recipient",
-			"type": "string"
-		  },
-			{
-			"indexed": false,
-			"internalType": "string",
-			"name": "metadata",
-			"type": "string"
-		  },
-		  {
-			"indexed": false,
-			"internalType": "uint256",
-			"name": "blocktime",
-			"type": "uint256"
-		  },
-			{
-				"indexed": false,
-				"internalType": "uint32",
-				"name": "chain",
-				"type": "uint32"
-			}
-		],
-		"name": "TokensLockedEvent",
-		"type": "event"
-	  }]`
-
+) (*ethereumEventsProcessor, error) {
 	tokensLockedEventAbi, err := abi.JSON(strings.NewReader(tokensLockedEventAbiStr))
-
 	if err != nil {
-		log.Error(err.Error())
-		return
+		return nil, err
 	}
 
-	transferCompletedEventTopic := crypto.Keccak256Hash([]byte("TransferCompletedEvent(bytes,uint256)"))
-	transferCompletedEventAbiStr := `[{
-		"anonymous": false,
-		"inputs": [
-		  {
-			"indexed": false,
-			"internalType": "bytes",
-			"name": "txId",
-			"type": "bytes"
-		  },
-		  {
-			"indexed": false,
-			"internalType": "uint256",
-			"name": "operationId",
-			"type": "uint256"
-		  }
-		],
-		"name": "TransferCompletedEvent",
-		"type": "event"
-	  }]`
-
 	transferCompletedEventAbi, err := abi.JSON(strings.NewReader(transferCompletedEventAbiStr))
+	if err != nil {
+		return nil, err
+	}
 
+	requestNewSignaturesEventAbi, err := abi.JSON(strings.NewReader(requestNewSignaturesEventAbiStr))
 	if err != nil {
-		log.Error(err.Error())
-		return
+		return nil, err
 	}
 
-	requestNewSignaturesEventTopic := crypto.Keccak256Hash([]byte("RequestNewSignaturesEvent(bytes,uint256)"))
-	requestNewSignaturesEventAbiStr := `[{
-		"anonymous": false,
-		"inputs": [
-		  {
-			"indexed": false,
-			"internalType": "bytes",
-			"name": "txId",
-			"type": "bytes"
-		  },
-		  {
-			"indexed": false,
-			"internalType": "uint256",
-			"name": "blocktime",
-			"type": "uint256"
-		  }
-		],
-		"name": "RequestNewSignaturesEvent",
-		"type": "event"
-	  }]`
+	koinosContractAddr, err := base58.Decode(koinosContractStr)
+	if err != nil {
+		return nil, err
+	}
 
-	requestNewSignaturesEventAbi, err := abi.JSON(strings.NewReader(requestNewSignaturesEventAbiStr))
+	return &ethereumEventsProcessor{
+		chain:                        chain,
+		koinosPK:                     koinosPK,
+		koinosAddress:                koinosAddress,
+		koinosContractAddr:           koinosContractAddr,
+		koinosTxStore:                koinosTxStore,
+		processedEventsStore:         processedEventsStore,
+		pendingTxStore:               pendingTxStore,
+		signingAuditLog:              signingAuditLog,
+		signaturesExpiration:         signaturesExpiration,
+		validators:                   validators,
+		tokensLockedEventAbi:         tokensLockedEventAbi,
+		transferCompletedEventAbi:    transferCompletedEventAbi,
+		requestNewSignaturesEventAbi: requestNewSignaturesEventAbi,
+	}, nil
+}
+
+// ethereumEventsFilterQuery returns the query matching the bridge events emitted between fromBlock and toBlock
+func ethereumEventsFilterQuery(ethContractAddr common.Address, fromBlock uint64, toBlock uint64) ethereum.FilterQuery {
+	return ethereum.FilterQuery{
+		FromBlock: big.NewInt(int64(fromBlock)),
+		ToBlock:   big.NewInt(int64(toBlock)),
+		Addresses: []common.Address{
+			ethContractAddr,
+		},
+		Topics: [][]common.Hash{
+			{
+				tokensLockedEventTopic,
+				transferCompletedEventTopic,
+				requestNewSignaturesEventTopic,
+			},
+		},
+	}
+}
+
+// processLog processes a log emitted by the Ethereum bridge contract and returns the name of the event,
+// empty if the log was ignored, and whether the event was already processed (in which case it is not processed again).
+// Each event processed is the root of a trace.
+func (processor *ethereumEventsProcessor) processLog(vLog types.Log) (string, bool) {
+	// do not processed removed logs
+	if vLog.Removed {
+		return "", false
+	}
+
+	txIdHex := vLog.TxHash.Hex()
+	eventKey := store.ProcessedEventKey(processor.chain.Name, vLog.BlockNumber, txIdHex, uint64(vLog.Index))
+
+	// the verifier may process the same event concurrently, released on panic too
+	unlockEvent := processor.processedEventsStore.LockEvent(eventKey)
+	defer unlockEvent()
+
+	ctx, span := tracing.Start(context.Background(), "ProcessEthereumLog",
+		attribute.String("chain", processor.chain.Name),
+		attribute.Int64("block.number", int64(vLog.BlockNumber)),
+		attribute.String("transaction.id", txIdHex),
+		attribute.Int64("log.index", int64(vLog.Index)),
+	)
+	defer span.End()
+
+	processedEvent := getProcessedEvent(processor.processedEventsStore, eventKey)
+	if processedEvent != nil {
+		span.SetAttributes(attribute.String("event", processedEvent.Name), attribute.Bool("already_processed", true))
+		log.Infof("Eth %s %s already processed | block: %d | tx: %s | log index: %d", processor.chain.Name, processedEvent.Name, vLog.BlockNumber, txIdHex, vLog.Index)
+		return processedEvent.Name, true
+	}
+
+	var name string
+
+	if vLog.Topics[0] == tokensLockedEventTopic {
+		// if TokensLockedEvent
+		processEthereumTokensLockedEvent(
+			ctx,
+			processor.signingAuditLog,
+			signingSource(processor.chain.Name, vLog.BlockNumber, txIdHex, uint64(vLog.Index), "TokensLockedEvent"),
+			processor.koinosPK,
+			processor.koinosAddress,
+			processor.koinosContractAddr,
+			processor.chain.ChainIdStr(),
+			processor.chain.TokenAddresses,
+			processor.chain.TxStore,
+			processor.pendingTxStore,
+			processor.signaturesExpiration,
+			processor.validators,
+			vLog,
+			processor.tokensLockedEventAbi,
+		)
+		name = "TokensLockedEvent"
+
+		// the transaction is now final
+		err := processor.chain.UnconfirmedTxStore.Delete(EthereumTransactionKey(txIdHex, uint64(vLog.Index)))
+		if err != nil {
+			log.Error(err.Error())
+			panic(err)
+		}
+	} else if vLog.Topics[0] == transferCompletedEventTopic {
+		// if TransferCompletedEvenet
+		processEthereumTransferCompletedEvent(
+			ctx,
+			signingSource(processor.chain.Name, vLog.BlockNumber, txIdHex, uint64(vLog.Index), "TransferCompletedEvent"),
+			processor.koinosTxStore,
+			vLog,
+			processor.transferCompletedEventAbi,
+		)
+		name = "TransferCompletedEvent"
+	} else if vLog.Topics[0] == requestNewSignaturesEventTopic {
+		// if RequestNewSignaturesEvent
+		processEthereumRequestNewSignaturesEvent(
+			ctx,
+			processor.signingAuditLog,
+			signingSource(processor.chain.Name, vLog.BlockNumber, txIdHex, uint64(vLog.Index), "RequestNewSignaturesEvent"),
+			processor.koinosPK,
+			processor.koinosAddress,
+			processor.koinosContractAddr,
+			processor.chain.TokenAddresses,
+			processor.chain.TxStore,
+			processor.signaturesExpiration,
+			processor.validators,
+			vLog,
+			processor.requestNewSignaturesEventAbi,
+		)
+		name = "RequestNewSignaturesEvent"
+	} else {
+		return "", false
+	}
+
+	span.SetAttributes(attribute.String("event", name))
+	putProcessedEvent(processor.processedEventsStore, eventKey, processor.chain.Name, vLog.BlockNumber, txIdHex, uint64(vLog.Index), name)
+
+	return name, false
+}
+
+// StreamEthereumBlocks streams the bridge events of an EVM chain until ctx is done or the chain cannot be streamed,
+// it returns the last block parsed (saved as the checkpoint of the chain) to restart from
+func StreamEthereumBlocks(
+	ctx context.Context,
+	metadataStore *store.MetadataStore,
+	startBlock uint64,
+	chain *EvmChain,
+	koinosPK []byte,
+	koinosAddress string,
+	koinosContractStr string,
+	koinosTxStore *store.TransactionsStore,
+	processedEventsStore *store.ProcessedEventsStore,
+	pendingTxStore *store.PendingTransactionsStore,
+	signingAuditLog *store.SigningAuditLogStore,
+	signaturesExpiration uint,
+	validators map[string]util.ValidatorConfig,
+	catchUpWorkers uint,
+) (uint64, error) {
+	processor, err := newEthereumEventsProcessor(
+		chain,
+		koinosPK,
+		koinosAddress,
+		koinosContractStr,
+		koinosTxStore,
+		processedEventsStore,
+		pendingTxStore,
+		signingAuditLog,
+		signaturesExpiration,
+		validators,
+	)
 
 	if err != nil {
-		log.Error(err.Error())
-		return
+		return startBlock, err
 	}
 
-	ethCl, err := ethclient.Dial(ethRPC)
+	ethCl, err := DialEvmChain(ctx, chain)
 
 	if err != nil {
-		log.Error(err.Error())
-		return
+		return startBlock, err
 	}
 
 	defer ethCl.Close()
 
-	fmt.Println("connected to Ethereum RPC")
+	fmt.Printf("connected to %s RPC\n", chain.Name)
+
+	lastEthereumBlockParsed := startBlock
+	defer func() {
+		saveEvmLastBlockParsed(metadataStore, chain.Name, lastEthereumBlockParsed)
+	}()
 
 	startBlock++
 
-	ethContractAddr := common.HexToAddress(ethContractStr)
-	koinosContractAddr, err := base58.Decode(koinosContractStr)
-	if err != nil {
-		log.Error(err.Error())
-		return
-	}
+	ethMaxBlocksToStream := chain.MaxBlocksToStream
 
-	var lastEthereumBlockParsed uint64
 	fromBlock := startBlock
 
 	for {
 		select {
 		case <-ctx.Done():
-			log.Infof("stop streaming logs: %d", lastEthereumBlockParsed)
-			metadataStore.Lock()
-			defer metadataStore.Unlock()
-
-			metadata, err := metadataStore.Get()
-			if err != nil {
-				log.Error(err.Error())
-				return
-			}
-
-			metadata.LastEthereumBlockParsed = lastEthereumBlockParsed
+			log.Infof("stop streaming %s logs: %d", chain.Name, lastEthereumBlockParsed)
+			return lastEthereumBlockParsed, nil
 
-			metadataStore.Put(metadata)
-			return
-
-		case <-time.After(time.Millisecond * time.Duration(ethPollingTime)):
-			latestblock, err := ethCl.BlockNumber(ctx)
+		case <-time.After(time.Millisecond * time.Duration(chain.PollingTime)):
+			// only the final blocks are streamed
+			latestblock, err := ethCl.LastFinalBlock(ctx)
 
 			if err != nil {
 				log.Error(err.Error())
 			} else {
-				log.Infof("latestblock: %d", latestblock)
+				log.Infof("%s last final block: %d", chain.Name, latestblock)
 
-				// trail by ethConfirmations blocks
-				if latestblock < ethConfirmations {
-					latestblock = 0
-				} else {
-					latestblock = latestblock - ethConfirmations
+				// far behind head, the logs are fetched in parallel without polling delay
+				if latestblock > fromBlock && latestblock-fromBlock > chain.CatchUpDistance {
+					lastEthereumBlockParsed, err = catchUpEthereumBlocks(ctx, ethCl, chain, processor, metadataStore, fromBlock, catchUpWorkers)
+					fromBlock = lastEthereumBlockParsed + 1
+
+					if err != nil {
+						log.Error(err.Error())
+					}
+					continue
 				}
 
 				var blockDelta uint64 = 0
@@ -242,67 +413,14 @@ func StreamEthereumBlocks(
 					toBlock = fromBlock + ethMaxBlocksToStream
 				}
 				if toBlock <= latestblock {
-					query := ethereum.FilterQuery{
-						FromBlock: big.NewInt(int64(fromBlock)),
-						ToBlock:   big.NewInt(int64(toBlock)),
-						Addresses: []common.Address{
-							ethContractAddr,
-						},
-						Topics: [][]common.Hash{
-							{
-								tokensLockedEventTopic,
-								transferCompletedEventTopic,
-								requestNewSignaturesEventTopic,
-							},
-						},
-					}
-					log.Infof("fetched eth logs: %d - %d", fromBlock, toBlock)
-
-					logs, err := ethCl.FilterLogs(ctx, query)
+					logs, toBlock, err := filterLogs(ctx, ethCl, chain, fromBlock, toBlock)
 					if err != nil {
 						log.Error(err.Error())
 					} else {
+						log.Infof("fetched %s logs: %d - %d", chain.Name, fromBlock, toBlock)
 
 						for _, vLog := range logs {
-							// do not processed removed logs
-							if vLog.Removed {
-								continue
-							}
-
-							if vLog.Topics[0] == tokensLockedEventTopic {
-								// if TokensLockedEvent
-								processEthereumTokensLockedEvent(
-									koinosPK,
-									koinosAddress,
-									koinosContractAddr,
-									tokenAddresses,
-									ethTxStore,
-									signaturesExpiration,
-									validators,
-									vLog,
-									tokensLockedEventAbi,
-								)
-							} else if vLog.Topics[0] == transferCompletedEventTopic {
-								// if TransferCompletedEvenet
-								processEthereumTransferCompletedEvent(
-									koinosTxStore,
-									vLog,
-									transferCompletedEventAbi,
-								)
-							} else if vLog.Topics[0] == requestNewSignaturesEventTopic {
-								// if RequestNewSignaturesEvent
-								processEthereumRequestNewSignaturesEvent(
-									koinosPK,
-									koinosAddress,
-									koinosContractAddr,
-									tokenAddresses,
-									ethTxStore,
-									signaturesExpiration,
-									validators,
-									vLog,
-									requestNewSignaturesEventAbi,
-								)
-							}
+							processor.processLog(vLog)
 
 							lastEthereumBlockParsed = vLog.BlockNumber
 						}
@@ -318,12 +436,21 @@ func StreamEthereumBlocks(
 				} else {
 					log.Info("waiting for block: " + fmt.Sprint(fromBlock))
 				}
+
+				// the locks of the blocks not final yet are tracked until they are
+				err = trackUnconfirmedLogs(ctx, ethCl, processor, latestblock, fromBlock)
+				if err != nil {
+					log.Error(err.Error())
+				}
 			}
 		}
 	}
 }
 
 func processEthereumRequestNewSignaturesEvent(
+	ctx context.Context,
+	signingAuditLog *store.SigningAuditLogStore,
+	source *bridge_pb.SigningAuditEntry,
 	koinosPK []byte,
 	koinosAddress string,
 	koinosContractAddr []byte,
@@ -350,12 +477,62 @@ func processEthereumRequestNewSignaturesEvent(
 	requestTxId := vLog.TxHash.Hex()
 	transactionId := "0x" + common.Bytes2Hex(event.TxId)
 	blocktime := event.Blocktime.Uint64()
-	newExpiration := blocktime + uint64(signaturesExpiration)
 
 	log.Infof("new Eth RequestNewSignaturesEvent | request block: %s | request tx: %s | tx: %s", blockNumber, requestTxId, transactionId)
 
 	ethTxStore.Lock()
-	ethTx, err := ethTxStore.Get(transactionId)
+	txKeys, _, err := GetEthereumTransactions(ethTxStore, transactionId)
+	ethTxStore.Unlock()
+
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	if len(txKeys) == 0 {
+		log.Infof("Eth tx %s does not exist", transactionId)
+		return
+	}
+
+	// the event only identifies the transaction, new signatures are requested for all its bridge events
+	for _, txKey := range txKeys {
+		requestNewEthereumSignatures(
+			ctx,
+			signingAuditLog,
+			source,
+			koinosPK,
+			koinosAddress,
+			koinosContractAddr,
+			ethTxStore,
+			signaturesExpiration,
+			validators,
+			txKey,
+			blocktime,
+		)
+	}
+}
+
+// requestNewEthereumSignatures signs again the bridge event txKey with a new expiration
+func requestNewEthereumSignatures(
+	ctx context.Context,
+	signingAuditLog *store.SigningAuditLogStore,
+	source *bridge_pb.SigningAuditEntry,
+	koinosPK []byte,
+	koinosAddress string,
+	koinosContractAddr []byte,
+	ethTxStore *store.TransactionsStore,
+	signaturesExpiration uint,
+	validators map[string]util.ValidatorConfig,
+	txKey string,
+	blocktime uint64,
+) {
+	storeLock := newProcessorLock(ethTxStore)
+	defer storeLock.releaseOnPanic()
+
+	newExpiration := blocktime + uint64(signaturesExpiration)
+
+	storeLock.Lock()
+	ethTx, err := ethTxStore.Get(txKey)
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
@@ -417,6 +594,7 @@ func processEthereumRequestNewSignaturesEvent(
 				Chain:         uint32(chain),
 			}
 
+			_, hashSpan := tracing.Start(ctx, "CompleteTransferHash")
 			completeTransferHashBytes, err := proto.Marshal(completeTransferHash)
 			if err != nil {
 				log.Error(err.Error())
@@ -425,8 +603,9 @@ func processEthereumRequestNewSignaturesEvent(
 
 			hash := sha256.Sum256(completeTransferHashBytes)
 			hashB64 := base64.URLEncoding.EncodeToString(hash[:])
+			hashSpan.End()
 
-			sigBytes := util.SignKoinosHash(koinosPK, hash[:])
+			sigBytes := util.SignKoinosHash(ctx, signingAuditLog, source, koinosPK, completeTransferHashBytes, hash[:])
 			sigB64 := base64.URLEncoding.EncodeToString(sigBytes)
 
 			// cleanup signatures
@@ -462,22 +641,22 @@ func processEthereumRequestNewSignaturesEvent(
 				ethTx.Status = bridge_pb.TransactionStatus_signed
 			}
 
-			err = ethTxStore.Put(transactionId, ethTx)
+			err = ethTxStore.Update(ctx, txKey, ethTx, transitionSource(bridge_pb.TransitionCause_streamer_event, source, ""))
 
 			if err != nil {
 				log.Error(err.Error())
 				panic(err)
 			}
 
-			ethTxStore.Unlock()
+			storeLock.Unlock()
 
 			// broadcast transaction
-			koinosSignatures, _ := util.BroadcastTransaction(ethTx, koinosPK, koinosAddress, validators)
+			koinosSignatures, _ := util.BroadcastTransaction(ctx, ethTx, koinosPK, koinosAddress, validators, signingAuditLog, source)
 
 			// update the transaction with signatures we may have gotten back from the broadcast
-			ethTxStore.Lock()
+			storeLock.Lock()
 
-			ethTx, err = ethTxStore.Get(transactionId)
+			ethTx, err = ethTxStore.Get(txKey)
 			if err != nil {
 				log.Error(err.Error())
 				panic(err)
@@ -502,29 +681,34 @@ func processEthereumRequestNewSignaturesEvent(
 				ethTx.Status = bridge_pb.TransactionStatus_signed
 			}
 
-			err = ethTxStore.Put(transactionId, ethTx)
+			err = ethTxStore.Update(ctx, txKey, ethTx, transitionSource(bridge_pb.TransitionCause_peer_submission, source, "broadcast responses"))
 
 			if err != nil {
 				log.Error(err.Error())
 				panic(err)
 			}
 
-			ethTxStore.Unlock()
+			storeLock.Unlock()
 		} else {
-			log.Infof("Cannot request new signatures for Eth tx %s yet (current blocktime %d vs allowed blocktime %d)", transactionId, blocktime, allowedRequestNewSignaturesBlockTime)
-			ethTxStore.Unlock()
+			log.Infof("Cannot request new signatures for Eth tx %s yet (current blocktime %d vs allowed blocktime %d)", txKey, blocktime, allowedRequestNewSignaturesBlockTime)
+			storeLock.Unlock()
 		}
 	} else {
-		log.Infof("Eth tx %s does not exist or is already completed", transactionId)
-		ethTxStore.Unlock()
+		log.Infof("Eth tx %s is already completed", txKey)
+		storeLock.Unlock()
 	}
 }
 
 func processEthereumTransferCompletedEvent(
+	ctx context.Context,
+	source *bridge_pb.SigningAuditEntry,
 	koinosTxStore *store.TransactionsStore,
 	vLog types.Log,
 	eventAbi abi.ABI,
 ) {
+	storeLock := newProcessorLock(koinosTxStore)
+	defer storeLock.releaseOnPanic()
+
 	// parse event
 	event := struct {
 		TxId        []byte
@@ -545,7 +729,7 @@ func processEthereumTransferCompletedEvent(
 	log.Infof("new Eth LogTransferCompleted event | block: %s | tx: %s | koinos tx: %s | koinos op: %s", blockNumber, ethTxId, koinosTxId, koinosOpId)
 
 	txKey := koinosTxId + "-" + koinosOpId
-	koinosTxStore.Lock()
+	storeLock.Lock()
 	koinosTx, err := koinosTxStore.Get(txKey)
 	if err != nil {
 		log.Error(err.Error())
@@ -561,37 +745,48 @@ func processEthereumTransferCompletedEvent(
 	koinosTx.Status = bridge_pb.TransactionStatus_completed
 	koinosTx.CompletionTransactionId = ethTxId
 
-	err = koinosTxStore.Put(txKey, koinosTx)
+	err = koinosTxStore.Update(ctx, txKey, koinosTx, transitionSource(bridge_pb.TransitionCause_streamer_event, source, ""))
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
-	koinosTxStore.Unlock()
+	storeLock.Unlock()
+}
+
+// tokensLockedEvent is a TokensLockedEvent emitted by the Ethereum bridge contract
+type tokensLockedEvent struct {
+	Token     common.Address
+	From      common.Address
+	Metadata  string
+	Relayer   string
+	Recipient string
+	Payment   *big.Int
+	Amount    *big.Int
+	Blocktime *big.Int
+	Chain     uint32
 }
 
 func processEthereumTokensLockedEvent(
+	ctx context.Context,
+	signingAuditLog *store.SigningAuditLogStore,
+	source *bridge_pb.SigningAuditEntry,
 	koinosPK []byte,
 	koinosAddress string,
 	koinosContractAddr []byte,
+	fromChain string,
 	tokenAddresses map[string]util.TokenConfig,
 	ethTxStore *store.TransactionsStore,
+	pendingTxStore *store.PendingTransactionsStore,
 	signaturesExpiration uint,
 	validators map[string]util.ValidatorConfig,
 	vLog types.Log,
 	eventAbi abi.ABI,
 ) {
+	storeLock := newProcessorLock(ethTxStore)
+	defer storeLock.releaseOnPanic()
+
 	// parse event
-	event := struct {
-		Token     common.Address
-		From      common.Address
-		Metadata  string
-		Relayer   string
-		Recipient string
-		Payment   *big.Int
-		Amount    *big.Int
-		Blocktime *big.Int
-		Chain     uint32
-	}{}
+	event := tokensLockedEvent{}
 
 	err := eventAbi.UnpackIntoInterface(&event, "TokensLockedEvent", vLog.Data)
 	if err != nil {
@@ -653,6 +848,7 @@ func processEthereumTokensLockedEvent(
 		Chain:         chain,
 	}
 
+	_, hashSpan := tracing.Start(ctx, "CompleteTransferHash")
 	completeTransferHashBytes, err := proto.Marshal(completeTransferHash)
 	if err != nil {
 		log.Error(err.Error())
@@ -661,19 +857,38 @@ func processEthereumTokensLockedEvent(
 
 	hash := sha256.Sum256(completeTransferHashBytes)
 	hashB64 := base64.URLEncoding.EncodeToString(hash[:])
+	hashSpan.End()
 
-	sigBytes := util.SignKoinosHash(koinosPK, hash[:])
+	sigBytes := util.SignKoinosHash(ctx, signingAuditLog, source, koinosPK, completeTransferHashBytes, hash[:])
 	sigB64 := base64.URLEncoding.EncodeToString(sigBytes)
 
 	// store the transaction
-	ethTxStore.Lock()
+	txKey := EthereumTransactionKey(txIdHex, uint64(vLog.Index))
+	storeLock.Lock()
 
-	ethTx, err := ethTxStore.Get(txIdHex)
+	ethTx, err := ethTxStore.Get(txKey)
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
 
+	// a transaction completed before being observed, or not migrated, is stored under its id alone
+	adopted := false
+	if ethTx == nil {
+		ethTx, err = ethTxStore.Get(txIdHex)
+		if err != nil {
+			log.Error(err.Error())
+			panic(err)
+		}
+
+		if ethTx != nil && ethTx.Hash != "" && ethTx.Hash != hashB64 {
+			// the transaction stored under its id is another event of the transaction
+			ethTx = nil
+		}
+
+		adopted = ethTx != nil
+	}
+
 	if ethTx == nil {
 		ethTx = &bridge_pb.Transaction{}
 		ethTx.Validators = []string{koinosAddress}
@@ -681,16 +896,16 @@ func processEthereumTokensLockedEvent(
 	} else {
 
 		if ethTx.Hash != "" && ethTx.Hash != hashB64 {
-			errMsg := fmt.Sprintf("the calculated hash for tx %s is different than the one already received %s != calculated %s", txIdHex, ethTx.Hash, hashB64)
+			errMsg := fmt.Sprintf("the calculated hash for tx %s is different than the one already received %s != calculated %s", txKey, ethTx.Hash, hashB64)
 			log.Errorf(errMsg)
 			panic(fmt.Errorf(errMsg))
 		}
-		ethTx.Validators = append(ethTx.Validators, koinosAddress)
-		ethTx.Signatures = append(ethTx.Signatures, sigB64)
+		setValidatorSignature(ethTx, koinosAddress, sigB64)
 	}
 
 	ethTx.Type = bridge_pb.TransactionType_ethereum
 	ethTx.Id = txIdHex
+	ethTx.OpId = fmt.Sprint(vLog.Index)
 	ethTx.From = ethFrom
 	ethTx.EthToken = ethToken
 	ethTx.KoinosToken = tokenAddresses[ethToken].KoinosAddress
@@ -704,26 +919,39 @@ func processEthereumTokensLockedEvent(
 	ethTx.BlockTime = blocktime
 	ethTx.Expiration = expiration
 	ethTx.ToChain = fmt.Sprint(chain)
+	ethTx.FromChain = fromChain
 	if ethTx.Status != bridge_pb.TransactionStatus_completed {
 		ethTx.Status = bridge_pb.TransactionStatus_gathering_signatures
 	}
 
-	err = ethTxStore.Put(txIdHex, ethTx)
+	mergePendingTransaction(pendingTxStore, source.Chain, txKey, ethTx)
+	// peers not keying the transactions by log index yet submit them under their id alone
+	mergePendingTransaction(pendingTxStore, source.Chain, txIdHex, ethTx)
+
+	err = ethTxStore.Update(ctx, txKey, ethTx, transitionSource(bridge_pb.TransitionCause_streamer_event, source, ""))
 
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
 
-	ethTxStore.Unlock()
+	if adopted {
+		err = ethTxStore.Delete(txIdHex)
+		if err != nil {
+			log.Error(err.Error())
+			panic(err)
+		}
+	}
+
+	storeLock.Unlock()
 
 	// broadcast transaction
-	signatures, _ := util.BroadcastTransaction(ethTx, koinosPK, koinosAddress, validators)
+	signatures, _ := util.BroadcastTransaction(ctx, ethTx, koinosPK, koinosAddress, validators, signingAuditLog, source)
 
 	// update the transaction with signatures we may have gotten back from the broadcast
-	ethTxStore.Lock()
+	storeLock.Lock()
 
-	ethTx, err = ethTxStore.Get(txIdHex)
+	ethTx, err = ethTxStore.Get(txKey)
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
@@ -748,12 +976,12 @@ func processEthereumTokensLockedEvent(
 		ethTx.Status = bridge_pb.TransactionStatus_signed
 	}
 
-	err = ethTxStore.Put(txIdHex, ethTx)
+	err = ethTxStore.Update(ctx, txKey, ethTx, transitionSource(bridge_pb.TransitionCause_peer_submission, source, "broadcast responses"))
 
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
 
-	ethTxStore.Unlock()
+	storeLock.Unlock()
 }
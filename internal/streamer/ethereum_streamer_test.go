@@ -0,0 +1,345 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mr-tron/base58"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+var (
+	testContractAddr = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+	testEthToken     = common.HexToAddress("0x00000000000000000000000000000000000000e1")
+	testKoinosToken  = base58.Encode(bytes.Repeat([]byte{2}, 25))
+	testRecipient    = base58.Encode(bytes.Repeat([]byte{3}, 25))
+	testKoinosPK     = bytes.Repeat([]byte{1}, 32)
+)
+
+// fakeEvmRPC is a JSON-RPC server serving the head, the safe and finalized blocks and the logs of an EVM chain,
+// the logs requests of more than maxRange blocks (when set) are rejected like a provider limiting the results
+type fakeEvmRPC struct {
+	head      uint64
+	final     uint64
+	logs      []types.Log
+	finalized uint
+	maxRange  uint64
+	ranges    [][2]uint64
+	// delays the logs requests by their first block when set, before locking the server
+	logsDelay func(fromBlock uint64) time.Duration
+	mutex     sync.Mutex
+}
+
+type jsonRPCRequest struct {
+	ID     json.RawMessage   `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+func (rpc *fakeEvmRPC) setFinal(final uint64) {
+	rpc.mutex.Lock()
+	defer rpc.mutex.Unlock()
+
+	rpc.final = final
+}
+
+// finalizedCalls returns the number of requests of the safe or finalized block
+func (rpc *fakeEvmRPC) finalizedCalls() uint {
+	rpc.mutex.Lock()
+	defer rpc.mutex.Unlock()
+
+	return rpc.finalized
+}
+
+func (rpc *fakeEvmRPC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	request := jsonRPCRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if request.Method == "eth_getLogs" && rpc.logsDelay != nil {
+		filter := struct {
+			FromBlock hexutil.Uint64 `json:"fromBlock"`
+		}{}
+		json.Unmarshal(request.Params[0], &filter)
+		time.Sleep(rpc.logsDelay(uint64(filter.FromBlock)))
+	}
+
+	rpc.mutex.Lock()
+	defer rpc.mutex.Unlock()
+
+	var result interface{}
+
+	switch request.Method {
+	case "eth_blockNumber":
+		result = hexutil.Uint64(rpc.head)
+
+	case "eth_getBlockByNumber":
+		var tag string
+		json.Unmarshal(request.Params[0], &tag)
+
+		if tag == FinalitySafe || tag == FinalityFinalized {
+			rpc.finalized++
+			result = map[string]interface{}{"number": hexutil.Uint64(rpc.final)}
+		}
+
+	case "eth_getTransactionReceipt":
+		var txHash common.Hash
+		json.Unmarshal(request.Params[0], &txHash)
+
+		for _, vLog := range rpc.logs {
+			if vLog.TxHash == txHash {
+				logCopy := vLog
+				result = &types.Receipt{
+					Status:      types.ReceiptStatusSuccessful,
+					TxHash:      txHash,
+					BlockHash:   vLog.BlockHash,
+					BlockNumber: new(big.Int).SetUint64(vLog.BlockNumber),
+					Logs:        []*types.Log{&logCopy},
+				}
+			}
+		}
+
+	case "eth_getLogs":
+		filter := struct {
+			FromBlock hexutil.Uint64 `json:"fromBlock"`
+			ToBlock   hexutil.Uint64 `json:"toBlock"`
+		}{}
+		json.Unmarshal(request.Params[0], &filter)
+		rpc.ranges = append(rpc.ranges, [2]uint64{uint64(filter.FromBlock), uint64(filter.ToBlock)})
+
+		if rpc.maxRange > 0 && uint64(filter.ToBlock-filter.FromBlock) > rpc.maxRange {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"jsonrpc": "2.0",
+				"id":      request.ID,
+				"error":   map[string]interface{}{"code": -32005, "message": "query returned more than 10000 results"},
+			})
+			return
+		}
+
+		logs := []types.Log{}
+		for _, vLog := range rpc.logs {
+			if vLog.BlockNumber >= uint64(filter.FromBlock) && vLog.BlockNumber <= uint64(filter.ToBlock) {
+				logs = append(logs, vLog)
+			}
+		}
+		result = logs
+
+	default:
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      request.ID,
+			"error":   map[string]interface{}{"code": -32601, "message": "method not found"},
+		})
+		return
+	}
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      request.ID,
+		"result":  result,
+	})
+}
+
+// testTokensLockedLog returns a TokensLockedEvent log emitted by the bridge contract in blockNumber
+func testTokensLockedLog(t *testing.T, blockNumber uint64) types.Log {
+	t.Helper()
+
+	eventAbi, err := abi.JSON(strings.NewReader(tokensLockedEventAbiStr))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := eventAbi.Events["TokensLockedEvent"].Inputs.Pack(
+		common.HexToAddress("0x00000000000000000000000000000000000000f1"),
+		testEthToken,
+		big.NewInt(1000),
+		big.NewInt(10),
+		"",
+		testRecipient,
+		"",
+		big.NewInt(time.Now().UnixMilli()),
+		uint32(1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return types.Log{
+		Address:     testContractAddr,
+		Topics:      []common.Hash{tokensLockedEventTopic},
+		Data:        data,
+		BlockNumber: blockNumber,
+		TxHash:      common.HexToHash("0x0102"),
+		TxIndex:     0,
+		BlockHash:   common.HexToHash("0x0304"),
+		Index:       3,
+	}
+}
+
+func newTestEvmChain(rpcUrl string, finality string, confirmations uint64) *EvmChain {
+	return NewEvmChain(&util.EvmChainConfig{
+		Name:            "ethereum",
+		Rpc:             rpcUrl,
+		Contract:        testContractAddr.Hex(),
+		MaxBlocksStream: 100,
+		Confirmations:   confirmations,
+		PollingTime:     10,
+		CatchUpDistance: 1000,
+		Finality:        finality,
+		Tokens: map[string]util.TokenConfig{
+			"token": {EthereumAddress: testEthToken.Hex(), KoinosAddress: testKoinosToken},
+		},
+	}, store.NewTransactionsStore(store.NewMapBackend()))
+}
+
+func TestLastFinalBlock(t *testing.T) {
+	rpc := &fakeEvmRPC{head: 100, final: 80}
+	server := httptest.NewServer(rpc)
+	defer server.Close()
+
+	tests := []struct {
+		finality      string
+		confirmations uint64
+		expected      uint64
+	}{
+		{FinalityConfirmations, 15, 85},
+		{FinalityConfirmations, 150, 0},
+		{FinalitySafe, 15, 80},
+		{FinalityFinalized, 15, 80},
+	}
+
+	for _, test := range tests {
+		ethCl, err := DialEvmChain(context.Background(), newTestEvmChain(server.URL, test.finality, test.confirmations))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lastFinalBlock, err := ethCl.LastFinalBlock(context.Background())
+		ethCl.Close()
+
+		if err != nil {
+			t.Fatalf("%s: %v", test.finality, err)
+		}
+		if lastFinalBlock != test.expected {
+			t.Fatalf("%s with %d confirmations: expected block %d, got %d", test.finality, test.confirmations, test.expected, lastFinalBlock)
+		}
+	}
+}
+
+func TestStreamEthereumBlocksWaitsForFinalBlock(t *testing.T) {
+	for _, finality := range []string{FinalitySafe, FinalityFinalized} {
+		t.Run(finality, func(t *testing.T) {
+			vLog := testTokensLockedLog(t, 15)
+			rpc := &fakeEvmRPC{head: 30, final: 10, logs: []types.Log{vLog}}
+			server := httptest.NewServer(rpc)
+			defer server.Close()
+
+			chain := newTestEvmChain(server.URL, finality, 0)
+			metadataStore := store.NewMetadataStore(store.NewMapBackend())
+			processedEventsStore := store.NewProcessedEventsStore(store.NewMapBackend())
+			signingAuditLog, err := store.NewSigningAuditLogStore(store.NewMapBackend())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+			go func() {
+				_, err := StreamEthereumBlocks(
+					ctx,
+					metadataStore,
+					0,
+					chain,
+					testKoinosPK,
+					"validator",
+					base58.Encode(bytes.Repeat([]byte{4}, 25)),
+					store.NewTransactionsStore(store.NewMapBackend()),
+					processedEventsStore,
+					store.NewPendingTransactionsStore(store.NewMapBackend()),
+					signingAuditLog,
+					60000,
+					map[string]util.ValidatorConfig{},
+					1,
+				)
+				done <- err
+			}()
+			defer func() {
+				cancel()
+				if err := <-done; err != nil {
+					t.Error(err)
+				}
+			}()
+
+			txKey := EthereumTransactionKey(vLog.TxHash.Hex(), uint64(vLog.Index))
+			eventKey := store.ProcessedEventKey(chain.Name, vLog.BlockNumber, vLog.TxHash.Hex(), uint64(vLog.Index))
+
+			// the log is above the final block, it is only tracked as unconfirmed
+			waitFor(t, "the log to be tracked as unconfirmed", func() bool {
+				ethTx, _ := chain.UnconfirmedTxStore.Get(txKey)
+				return ethTx != nil && rpc.finalizedCalls() >= 3
+			})
+
+			ethTx, err := chain.TxStore.Get(txKey)
+			if err != nil || ethTx != nil {
+				t.Fatalf("expected the transaction not to be stored before its block is final, got %v, %v", ethTx, err)
+			}
+			if signingAuditLog.Head() != nil {
+				t.Fatal("expected nothing to be signed before the block is final")
+			}
+			if event, _ := processedEventsStore.Get(eventKey); event != nil {
+				t.Fatal("expected the event not to be processed before the block is final")
+			}
+
+			// the final block passes the log
+			rpc.setFinal(20)
+
+			// the event is recorded once processed
+			waitFor(t, "the event to be processed", func() bool {
+				event, _ := processedEventsStore.Get(eventKey)
+				return event != nil
+			})
+
+			ethTx, _ = chain.TxStore.Get(txKey)
+			if ethTx == nil {
+				t.Fatal("expected the transaction to be stored once its block is final")
+			}
+			if len(ethTx.Signatures) != 1 || ethTx.Validators[0] != "validator" {
+				t.Fatalf("expected the transaction to be signed by the validator, got %+q", ethTx.Validators)
+			}
+			if signingAuditLog.Head() == nil {
+				t.Fatal("expected the signature to be recorded in the signing audit log")
+			}
+			if unconfirmedTx, _ := chain.UnconfirmedTxStore.Get(txKey); unconfirmedTx != nil {
+				t.Fatal("expected the transaction not to be tracked as unconfirmed anymore")
+			}
+		})
+	}
+}
+
+func waitFor(t *testing.T, description string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %s", description)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
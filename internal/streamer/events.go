@@ -0,0 +1,154 @@
+package streamer
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+const (
+	ChainEthereum = "ethereum"
+	ChainKoinos   = "koinos"
+)
+
+// getProcessedEvent returns the record of an event if it was already processed, nil otherwise
+func getProcessedEvent(processedEventsStore *store.ProcessedEventsStore, eventKey string) *bridge_pb.ProcessedEvent {
+	processedEvent, err := processedEventsStore.Get(eventKey)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	return processedEvent
+}
+
+// putProcessedEvent records that an event was processed so that processing it again is a no-op
+func putProcessedEvent(processedEventsStore *store.ProcessedEventsStore, eventKey string, chain string, blockNumber uint64, transactionId string, index uint64, name string) {
+	err := processedEventsStore.Put(eventKey, &bridge_pb.ProcessedEvent{
+		Chain:         chain,
+		BlockNumber:   blockNumber,
+		TransactionId: transactionId,
+		Index:         index,
+		Name:          name,
+		ProcessedAt:   uint64(time.Now().UnixMilli()),
+	})
+
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+}
+
+// mergePendingTransaction merges in tx the signatures submitted by the peers before tx was observed,
+// only the peers that submitted the hash observed are merged. The transactions store of tx must be locked.
+func mergePendingTransaction(pendingTxStore *store.PendingTransactionsStore, chain string, txKey string, tx *bridge_pb.Transaction) {
+	key := store.PendingTransactionKey(chain, txKey, tx.Hash)
+
+	pendingTxStore.Lock()
+	defer pendingTxStore.Unlock()
+
+	pendingTx, err := pendingTxStore.Get(key)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	if pendingTx == nil {
+		return
+	}
+
+	count := 0
+	for index, validatr := range pendingTx.Transaction.Validators {
+		found := false
+		for _, val := range tx.Validators {
+			if val == validatr {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			tx.Validators = append(tx.Validators, validatr)
+			tx.Signatures = append(tx.Signatures, pendingTx.Transaction.Signatures[index])
+			count++
+		}
+	}
+
+	err = pendingTxStore.Delete(key)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	log.Infof("merged %d signatures submitted by %+q for %s tx %s", count, pendingTx.Submitters, chain, txKey)
+}
+
+// signingSource returns the event that triggered a signature, as recorded in the signing audit log
+func signingSource(chain string, blockNumber uint64, transactionId string, index uint64, name string) *bridge_pb.SigningAuditEntry {
+	return &bridge_pb.SigningAuditEntry{
+		Chain:         chain,
+		BlockNumber:   blockNumber,
+		TransactionId: transactionId,
+		Index:         index,
+		Event:         name,
+	}
+}
+
+// transitionSource returns the cause of the transitions of the transactions updated while processing the event of source
+func transitionSource(cause bridge_pb.TransitionCause, source *bridge_pb.SigningAuditEntry, details string) *bridge_pb.TransactionTransition {
+	return &bridge_pb.TransactionTransition{
+		Cause:              cause,
+		Event:              source.Event,
+		EventChain:         source.Chain,
+		EventBlockNumber:   source.BlockNumber,
+		EventTransactionId: source.TransactionId,
+		EventIndex:         source.Index,
+		Details:            details,
+	}
+}
+
+// setValidatorSignature sets the signature of a validator, the same event may be processed
+// more than once (rescans) and a validator must only appear once in a transaction
+func setValidatorSignature(tx *bridge_pb.Transaction, validator string, signature string) {
+	for index, validatr := range tx.Validators {
+		if validatr == validator {
+			tx.Signatures[index] = signature
+			return
+		}
+	}
+
+	tx.Validators = append(tx.Validators, validator)
+	tx.Signatures = append(tx.Signatures, signature)
+}
+
+// processorLock is the lock of a store held by a processor, the processors panic on storage errors
+// and the lock is released on panic so that the supervisor can restart the streamer
+type processorLock struct {
+	locker sync.Locker
+	held   bool
+}
+
+func newProcessorLock(locker sync.Locker) *processorLock {
+	return &processorLock{locker: locker}
+}
+
+func (lock *processorLock) Lock() {
+	lock.locker.Lock()
+	lock.held = true
+}
+
+func (lock *processorLock) Unlock() {
+	lock.held = false
+	lock.locker.Unlock()
+}
+
+// releaseOnPanic must be deferred by the processor, it unlocks the store if it is still held
+func (lock *processorLock) releaseOnPanic() {
+	if lock.held {
+		lock.Unlock()
+	}
+}
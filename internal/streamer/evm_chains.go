@@ -0,0 +1,233 @@
+package streamer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// EvmChain is an EVM chain bridged with Koinos
+type EvmChain struct {
+	Name string
+	// 0 when not configured, the chain then receives the transfers to the chain ids not configured
+	ChainId           uint64
+	Rpc               string
+	ContractAddr      common.Address
+	MaxBlocksToStream uint64
+	Confirmations     uint64
+	PollingTime       uint
+	// distance to head under which the streamer stops catching up and polls the chain
+	CatchUpDistance uint64
+	// finality mode, the events of a block are processed once the block is final
+	Finality string
+	// tokens of the chain, keyed by both their EVM and Koinos addresses
+	TokenAddresses map[string]util.TokenConfig
+	TxStore        *store.TransactionsStore
+	// TokensLockedEvent not final yet, kept in memory until they are
+	UnconfirmedTxStore *store.TransactionsStore
+	// block ranges of the FilterLogs requests, adapted to the limits of the provider
+	LogsWindow *LogsWindow
+}
+
+// NewEvmChain creates a new EvmChain from its config
+func NewEvmChain(config *util.EvmChainConfig, txStore *store.TransactionsStore) *EvmChain {
+	tokenAddresses := make(map[string]util.TokenConfig)
+	for _, tokenAddr := range config.Tokens {
+		tokenAddresses[tokenAddr.KoinosAddress] = tokenAddr
+		tokenAddresses[tokenAddr.EthereumAddress] = tokenAddr
+	}
+
+	return &EvmChain{
+		Name:               config.Name,
+		ChainId:            config.ChainId,
+		Rpc:                config.Rpc,
+		ContractAddr:       common.HexToAddress(config.Contract),
+		MaxBlocksToStream:  config.MaxBlocksStream,
+		Confirmations:      config.Confirmations,
+		Finality:           config.Finality,
+		PollingTime:        config.PollingTime,
+		TokenAddresses:     tokenAddresses,
+		TxStore:            txStore,
+		UnconfirmedTxStore: store.NewTransactionsStore(store.NewMapBackend()),
+		CatchUpDistance:    config.CatchUpDistance,
+		LogsWindow:         NewLogsWindow(config.MaxBlocksStream),
+	}
+}
+
+// FinalityStr describes the finality of the chain, e.g. "15 confirmations" or "finalized block"
+func (chain *EvmChain) FinalityStr() string {
+	if chain.Finality == FinalitySafe || chain.Finality == FinalityFinalized {
+		return chain.Finality + " block"
+	}
+
+	return fmt.Sprintf("%d confirmations", chain.Confirmations)
+}
+
+// ChainIdStr returns the chain id as stored in the transactions, empty if not configured
+func (chain *EvmChain) ChainIdStr() string {
+	if chain.ChainId == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(chain.ChainId, 10)
+}
+
+// EvmChains are the EVM chains bridged with Koinos, the first one is the primary chain
+type EvmChains []*EvmChain
+
+// HandshakeContracts returns the bridge contracts of the chains advertised to the peers, keyed by chain name
+func (chains EvmChains) HandshakeContracts() map[string]util.HandshakeEvmContract {
+	contracts := make(map[string]util.HandshakeEvmContract)
+	for _, chain := range chains {
+		contracts[chain.Name] = util.HandshakeEvmContract{
+			ChainId:  chain.ChainId,
+			Contract: chain.ContractAddr.Hex(),
+		}
+	}
+
+	return contracts
+}
+
+// Get returns the chain with the given name, nil if it does not exist
+func (chains EvmChains) Get(name string) *EvmChain {
+	for _, chain := range chains {
+		if chain.Name == name {
+			return chain
+		}
+	}
+
+	return nil
+}
+
+// ByChainId returns the chain a transfer to chainId is routed to,
+// nil if chainId is not configured and all the chains have a chain id
+func (chains EvmChains) ByChainId(chainId string) *EvmChain {
+	var defaultChain *EvmChain
+
+	for _, chain := range chains {
+		if chain.ChainId == 0 {
+			defaultChain = chain
+		} else if chainId != "" && strconv.FormatUint(chain.ChainId, 10) == chainId {
+			return chain
+		}
+	}
+
+	return defaultChain
+}
+
+// GetTransaction looks for the transaction key in the transactions stores of the chains
+// and returns the chain storing it, or nil, nil if none does
+func (chains EvmChains) GetTransaction(key string) (*EvmChain, *bridge_pb.Transaction, error) {
+	for _, chain := range chains {
+		tx, err := chain.TxStore.Get(key)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if tx != nil {
+			return chain, tx, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// GetTransactions looks for the bridge events of the transaction txId in the transactions stores of the chains
+// and returns the chain storing them, with their keys, or nil, nil, nil, nil if none does
+func (chains EvmChains) GetTransactions(txId string) (*EvmChain, []string, []*bridge_pb.Transaction, error) {
+	for _, chain := range chains {
+		keys, txs, err := GetEthereumTransactions(chain.TxStore, txId)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(txs) > 0 {
+			return chain, keys, txs, nil
+		}
+	}
+
+	return nil, nil, nil, nil
+}
+
+// EthereumTransactionKey returns the key of the bridge event emitted at logIndex in the Ethereum transaction txId,
+// a transaction (e.g. from a batching contract) may emit more than one bridge event
+func EthereumTransactionKey(txId string, logIndex uint64) string {
+	return fmt.Sprintf("%s-%d", txId, logIndex)
+}
+
+// GetEthereumTransactions returns the bridge events of the Ethereum transaction txId stored in txStore, with their keys.
+// A transaction stored under txId alone (completed before being observed, or not migrated) comes first.
+func GetEthereumTransactions(txStore *store.TransactionsStore, txId string) ([]string, []*bridge_pb.Transaction, error) {
+	keys := []string{}
+	txs := []*bridge_pb.Transaction{}
+
+	tx, err := txStore.Get(txId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tx != nil {
+		keys = append(keys, txId)
+		txs = append(txs, tx)
+	}
+
+	err = txStore.IteratePrefix(txId+"-", func(key string, tx *bridge_pb.Transaction) error {
+		keys = append(keys, key)
+		txs = append(txs, tx)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keys, txs, nil
+}
+
+// GetEvmLastBlockParsed returns the checkpoint of the EVM chain, the chain named
+// "ethereum" keeps using last_ethereum_block_parsed
+func GetEvmLastBlockParsed(metadata *bridge_pb.Metadata, chainName string) uint64 {
+	if chainName == ChainEthereum {
+		return metadata.LastEthereumBlockParsed
+	}
+
+	return metadata.LastEvmBlocksParsed[chainName]
+}
+
+// SetEvmLastBlockParsed sets the checkpoint of the EVM chain
+func SetEvmLastBlockParsed(metadata *bridge_pb.Metadata, chainName string, block uint64) {
+	if chainName == ChainEthereum {
+		metadata.LastEthereumBlockParsed = block
+		return
+	}
+
+	if metadata.LastEvmBlocksParsed == nil {
+		metadata.LastEvmBlocksParsed = make(map[string]uint64)
+	}
+
+	metadata.LastEvmBlocksParsed[chainName] = block
+}
+
+// saveEvmLastBlockParsed saves the checkpoint of the EVM chain
+func saveEvmLastBlockParsed(metadataStore *store.MetadataStore, chainName string, block uint64) {
+	metadataStore.Lock()
+	defer metadataStore.Unlock()
+
+	metadata, err := metadataStore.Get()
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	SetEvmLastBlockParsed(metadata, chainName, block)
+
+	err = metadataStore.Put(metadata)
+	if err != nil {
+		log.Error(err.Error())
+	}
+}
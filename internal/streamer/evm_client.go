@@ -0,0 +1,80 @@
+package streamer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+)
+
+// finality modes of the EVM chains, the events of a block are processed once the block is final
+const (
+	// the block has the confirmations of the chain
+	FinalityConfirmations = "confirmations"
+	// the block is at or below the "safe" block of the RPC
+	FinalitySafe = "safe"
+	// the block is at or below the "finalized" block of the RPC
+	FinalityFinalized = "finalized"
+)
+
+// IsValidFinality returns true if finality is a finality mode
+func IsValidFinality(finality string) bool {
+	return finality == FinalityConfirmations || finality == FinalitySafe || finality == FinalityFinalized
+}
+
+// EvmClient is a client of the RPC of an EVM chain aware of the finality of the chain
+type EvmClient struct {
+	*ethclient.Client
+	rpcClient *gethrpc.Client
+	chain     *EvmChain
+}
+
+// DialEvmChain connects to the RPC of the chain
+func DialEvmChain(ctx context.Context, chain *EvmChain) (*EvmClient, error) {
+	rpcClient, err := gethrpc.DialContext(ctx, chain.Rpc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EvmClient{
+		Client:    ethclient.NewClient(rpcClient),
+		rpcClient: rpcClient,
+		chain:     chain,
+	}, nil
+}
+
+// LastFinalBlock returns the last block of the chain final according to the finality mode of the chain
+func (client *EvmClient) LastFinalBlock(ctx context.Context) (uint64, error) {
+	switch client.chain.Finality {
+	case FinalitySafe, FinalityFinalized:
+		// only the number is read, the headers of some chains lack fields required by types.Header
+		var header struct {
+			Number *hexutil.Big `json:"number"`
+		}
+
+		err := client.rpcClient.CallContext(ctx, &header, "eth_getBlockByNumber", client.chain.Finality, false)
+		if err != nil {
+			return 0, err
+		}
+
+		if header.Number == nil {
+			return 0, fmt.Errorf("%s %s block not available", client.chain.Name, client.chain.Finality)
+		}
+
+		return header.Number.ToInt().Uint64(), nil
+
+	default:
+		latestblock, err := client.BlockNumber(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		if latestblock < client.chain.Confirmations {
+			return 0, nil
+		}
+
+		return latestblock - client.chain.Confirmations, nil
+	}
+}
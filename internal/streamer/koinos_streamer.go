@@ -6,7 +6,7 @@ import (
 	"crypto/ecdsa"
 	"fmt"
 	"strconv"
-	"sync"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -15,70 +15,259 @@ import (
 	"github.com/koinos/koinos-proto-golang/koinos/rpc/block_store"
 	kjsonrpc "github.com/koinos/koinos-util-golang/rpc"
 	"github.com/mr-tron/base58"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/rpc"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/tracing"
 	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
 	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
 )
 
+// koinosEventsProcessor processes the events emitted by the Koinos bridge contract
+type koinosEventsProcessor struct {
+	ethereumPK           *ecdsa.PrivateKey
+	ethereumAddress      string
+	evmChains            EvmChains
+	koinosPK             []byte
+	koinosAddress        string
+	koinosContractAddr   []byte
+	koinosTxStore        *store.TransactionsStore
+	processedEventsStore *store.ProcessedEventsStore
+	pendingTxStore       *store.PendingTransactionsStore
+	signingAuditLog      *store.SigningAuditLogStore
+	signaturesExpiration uint
+	validators           map[string]util.ValidatorConfig
+	alertWebhook         string
+}
+
+func newKoinosEventsProcessor(
+	ethereumPK *ecdsa.PrivateKey,
+	ethereumAddress string,
+	evmChains EvmChains,
+	koinosPK []byte,
+	koinosAddress string,
+	koinosContractStr string,
+	koinosTxStore *store.TransactionsStore,
+	processedEventsStore *store.ProcessedEventsStore,
+	pendingTxStore *store.PendingTransactionsStore,
+	signingAuditLog *store.SigningAuditLogStore,
+	signaturesExpiration uint,
+	validators map[string]util.ValidatorConfig,
+	alertWebhook string,
+) (*koinosEventsProcessor, error) {
+	koinosContractAddr, err := base58.Decode(koinosContractStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &koinosEventsProcessor{
+		ethereumPK:           ethereumPK,
+		ethereumAddress:      ethereumAddress,
+		evmChains:            evmChains,
+		koinosPK:             koinosPK,
+		koinosAddress:        koinosAddress,
+		koinosContractAddr:   koinosContractAddr,
+		koinosTxStore:        koinosTxStore,
+		processedEventsStore: processedEventsStore,
+		pendingTxStore:       pendingTxStore,
+		signingAuditLog:      signingAuditLog,
+		signaturesExpiration: signaturesExpiration,
+		validators:           validators,
+		alertWebhook:         alertWebhook,
+	}, nil
+}
+
+// processEvent processes an event of a block and returns the name of the event, empty if the event was ignored,
+// and whether the event was already processed (in which case it is not processed again). Each event processed is the root of a trace.
+func (processor *koinosEventsProcessor) processEvent(block *block_store.BlockItem, receipt *protocol.TransactionReceipt, event *protocol.EventData) (string, bool) {
+	if !bytes.Equal(event.Source, processor.koinosContractAddr) {
+		return "", false
+	}
+
+	txIdHex := "0x" + common.Bytes2Hex(receipt.Id)
+	eventKey := store.ProcessedEventKey(ChainKoinos, block.BlockHeight, txIdHex, uint64(event.Sequence))
+
+	// the verifier may process the same event concurrently, released on panic too
+	unlockEvent := processor.processedEventsStore.LockEvent(eventKey)
+	defer unlockEvent()
+
+	ctx, span := tracing.Start(context.Background(), "ProcessKoinosEvent",
+		attribute.String("chain", ChainKoinos),
+		attribute.Int64("block.number", int64(block.BlockHeight)),
+		attribute.String("transaction.id", txIdHex),
+		attribute.Int64("event.sequence", int64(event.Sequence)),
+		attribute.String("event", event.Name),
+	)
+	defer span.End()
+
+	processedEvent := getProcessedEvent(processor.processedEventsStore, eventKey)
+	if processedEvent != nil {
+		span.SetAttributes(attribute.Bool("already_processed", true))
+		log.Infof("Koinos %s already processed | block: %d | tx: %s | sequence: %d", processedEvent.Name, block.BlockHeight, txIdHex, event.Sequence)
+		return processedEvent.Name, true
+	}
+
+	if event.Name == "bridge.tokens_locked_event" {
+		processed := processKoinosTokensLockedEvent(
+			ctx,
+			processor.signingAuditLog,
+			signingSource(ChainKoinos, block.BlockHeight, txIdHex, uint64(event.Sequence), event.Name),
+			processor.ethereumPK,
+			processor.ethereumAddress,
+			processor.koinosPK,
+			processor.koinosAddress,
+			processor.evmChains,
+			processor.koinosTxStore,
+			processor.pendingTxStore,
+			processor.signaturesExpiration,
+			processor.validators,
+			processor.alertWebhook,
+			block,
+			receipt,
+			event,
+		)
+
+		// a lock to a chain not configured is not recorded as processed, so that it is processed once the chain is configured
+		if !processed {
+			return "", false
+		}
+	} else if event.Name == "bridge.transfer_completed_event" {
+		processKoinosTransferCompletedEvent(
+			ctx,
+			signingSource(ChainKoinos, block.BlockHeight, txIdHex, uint64(event.Sequence), event.Name),
+			processor.evmChains,
+			block,
+			receipt,
+			event,
+		)
+	} else if event.Name == "bridge.request_new_signatures_event" {
+		processRequestNewSignaturesEvent(
+			ctx,
+			processor.signingAuditLog,
+			signingSource(ChainKoinos, block.BlockHeight, txIdHex, uint64(event.Sequence), event.Name),
+			processor.koinosTxStore,
+			block,
+			receipt,
+			event,
+			processor.signaturesExpiration,
+			processor.ethereumPK,
+			processor.ethereumAddress,
+			processor.koinosPK,
+			processor.koinosAddress,
+			processor.evmChains,
+			processor.validators,
+		)
+	} else {
+		return "", false
+	}
+
+	putProcessedEvent(processor.processedEventsStore, eventKey, ChainKoinos, block.BlockHeight, txIdHex, uint64(event.Sequence), event.Name)
+
+	return event.Name, false
+}
+
+// processBlock processes the events of the transactions that did not revert in a block
+func (processor *koinosEventsProcessor) processBlock(block *block_store.BlockItem, onEvent func(receipt *protocol.TransactionReceipt, event *protocol.EventData, name string, alreadyProcessed bool)) {
+	for _, receipt := range block.Receipt.TransactionReceipts {
+		// make the sure the transaction did not revert
+		if !receipt.Reverted {
+			// check each events
+			for _, event := range receipt.Events {
+				name, alreadyProcessed := processor.processEvent(block, receipt, event)
+				if name != "" && onEvent != nil {
+					onEvent(receipt, event, name, alreadyProcessed)
+				}
+			}
+		}
+	}
+}
+
+// GetKoinosTransactions returns the bridge operations of the Koinos transaction txId stored in koinosTxStore, with their keys.
+// The transactions are keyed by transaction id and operation id (the sequence of the event), their keys index
+// the operations of each transaction.
+func GetKoinosTransactions(koinosTxStore *store.TransactionsStore, txId string) ([]string, []*bridge_pb.Transaction, error) {
+	keys := []string{}
+	txs := []*bridge_pb.Transaction{}
+
+	err := koinosTxStore.IteratePrefix(txId+"-", func(key string, tx *bridge_pb.Transaction) error {
+		keys = append(keys, key)
+		txs = append(txs, tx)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keys, txs, nil
+}
+
+// StreamKoinosBlocks streams the bridge events of Koinos until ctx is done or Koinos cannot be streamed,
+// it returns the last block parsed (saved as the Koinos checkpoint) to restart from
 func StreamKoinosBlocks(
-	wg *sync.WaitGroup,
 	ctx context.Context,
 	metadataStore *store.MetadataStore,
 	startBlock uint64,
 	koinosRPC string,
 	ethereumPK *ecdsa.PrivateKey,
 	ethereumAddress string,
-	ethContractStr string,
+	evmChains EvmChains,
 	koinosMaxBlocksToStream uint64,
 	koinosPK []byte,
 	koinosAddress string,
 	koinosContractStr string,
-	tokenAddresses map[string]util.TokenConfig,
-	ethTxStore *store.TransactionsStore,
 	koinosTxStore *store.TransactionsStore,
+	processedEventsStore *store.ProcessedEventsStore,
+	pendingTxStore *store.PendingTransactionsStore,
+	signingAuditLog *store.SigningAuditLogStore,
 	signaturesExpiration uint,
 	validators map[string]util.ValidatorConfig,
+	alertWebhook string,
 	koinosPollingTime uint,
-) {
-	defer wg.Done()
+	koinosCatchUpDistance uint64,
+	catchUpWorkers uint,
+) (uint64, error) {
+	processor, err := newKoinosEventsProcessor(
+		ethereumPK,
+		ethereumAddress,
+		evmChains,
+		koinosPK,
+		koinosAddress,
+		koinosContractStr,
+		koinosTxStore,
+		processedEventsStore,
+		pendingTxStore,
+		signingAuditLog,
+		signaturesExpiration,
+		validators,
+		alertWebhook,
+	)
+
+	if err != nil {
+		return startBlock, err
+	}
+
 	// init JSON RPC client
 	rpcCl := kjsonrpc.NewKoinosRPCClient(koinosRPC)
 	rpcClient := rpc.NewJsonRPC(rpcCl)
 
 	fmt.Println("connected to Koinos RPC")
 
-	startBlock++
+	lastKoinosBlockParsed := startBlock
+	defer func() {
+		saveKoinosLastBlockParsed(metadataStore, lastKoinosBlockParsed)
+	}()
 
-	ethContractAddr := common.HexToAddress(ethContractStr)
-	koinosContractAddr, err := base58.Decode(koinosContractStr)
-	if err != nil {
-		log.Error(err.Error())
-		return
-	}
+	startBlock++
 
-	var lastKoinosBlockParsed uint64
 	fromBlock := startBlock
 
 	for {
 		select {
 		case <-ctx.Done():
 			log.Infof("stop streaming blocks %d", lastKoinosBlockParsed)
-			metadataStore.Lock()
-			defer metadataStore.Unlock()
-
-			metadata, err := metadataStore.Get()
-			if err != nil {
-				log.Error(err.Error())
-				return
-			}
-
-			metadata.LastKoinosBlockParsed = lastKoinosBlockParsed
-			metadataStore.Put(metadata)
-
-			return
+			return lastKoinosBlockParsed, nil
 
 		case <-time.After(time.Millisecond * time.Duration(koinosPollingTime)):
 			headInfo, err := rpcClient.GetHeadInfo(ctx)
@@ -88,6 +277,17 @@ func StreamKoinosBlocks(
 			} else {
 				log.Infof("last irreversible block: %d", headInfo.LastIrreversibleBlock)
 
+				// far behind the last irreversible block, the blocks are fetched in parallel without polling delay
+				if headInfo.LastIrreversibleBlock > fromBlock && headInfo.LastIrreversibleBlock-fromBlock > koinosCatchUpDistance {
+					lastKoinosBlockParsed, err = catchUpKoinosBlocks(ctx, rpcClient, processor, metadataStore, fromBlock, koinosMaxBlocksToStream, koinosCatchUpDistance, catchUpWorkers)
+					fromBlock = lastKoinosBlockParsed + 1
+
+					if err != nil {
+						log.Error(err.Error())
+					}
+					continue
+				}
+
 				var nbBlocksToFetch uint64 = 0
 
 				if headInfo.LastIrreversibleBlock > fromBlock {
@@ -109,53 +309,7 @@ func StreamKoinosBlocks(
 						log.Infof("fetched koinos blocks: %d - %d", fromBlock, toBlock)
 
 						for _, block := range blocks.BlockItems {
-							for _, receipt := range block.Receipt.TransactionReceipts {
-								// make the sure the transaction did not revert
-								if !receipt.Reverted {
-									// check each events
-									for _, event := range receipt.Events {
-										if bytes.Equal(event.Source, koinosContractAddr) {
-											if event.Name == "bridge.tokens_locked_event" {
-												processKoinosTokensLockedEvent(
-													ethereumPK,
-													ethereumAddress,
-													koinosPK,
-													koinosAddress,
-													ethContractAddr,
-													tokenAddresses,
-													koinosTxStore,
-													signaturesExpiration,
-													validators,
-													block,
-													receipt,
-													event,
-												)
-											} else if event.Name == "bridge.transfer_completed_event" {
-												processKoinosTransferCompletedEvent(
-													ethTxStore,
-													block,
-													receipt,
-													event,
-												)
-											} else if event.Name == "bridge.request_new_signatures_event" {
-												processRequestNewSignaturesEvent(
-													koinosTxStore,
-													block,
-													receipt,
-													event,
-													signaturesExpiration,
-													ethereumPK,
-													ethereumAddress,
-													koinosPK,
-													koinosAddress,
-													ethContractAddr,
-													validators,
-												)
-											}
-										}
-									}
-								}
-							}
+							processor.processBlock(block, nil)
 
 							lastKoinosBlockParsed = block.BlockHeight
 						}
@@ -172,7 +326,29 @@ func StreamKoinosBlocks(
 	}
 }
 
+// saveKoinosLastBlockParsed saves the checkpoint of the Koinos streamer
+func saveKoinosLastBlockParsed(metadataStore *store.MetadataStore, block uint64) {
+	metadataStore.Lock()
+	defer metadataStore.Unlock()
+
+	metadata, err := metadataStore.Get()
+	if err != nil {
+		log.Error(err.Error())
+		return
+	}
+
+	metadata.LastKoinosBlockParsed = block
+
+	err = metadataStore.Put(metadata)
+	if err != nil {
+		log.Error(err.Error())
+	}
+}
+
 func processRequestNewSignaturesEvent(
+	ctx context.Context,
+	signingAuditLog *store.SigningAuditLogStore,
+	source *bridge_pb.SigningAuditEntry,
 	koinosTxStore *store.TransactionsStore,
 	block *block_store.BlockItem,
 	receipt *protocol.TransactionReceipt,
@@ -182,7 +358,7 @@ func processRequestNewSignaturesEvent(
 	ethereumAddress string,
 	koinosPK []byte,
 	koinosAddress string,
-	ethereumContractAddr common.Address,
+	evmChains EvmChains,
 	validators map[string]util.ValidatorConfig,
 ) {
 	// parse event
@@ -197,36 +373,82 @@ func processRequestNewSignaturesEvent(
 	transactionId := requestNewSignaturesEvent.TransactionId
 	operationId := requestNewSignaturesEvent.OperationId
 	blocktime := block.Block.Header.Timestamp
-	newExpiration := blocktime + uint64(signaturesExpiration)
 
 	log.Infof("new Koinos request_new_signatures_event | block: %s | tx: %s | op_id: %s | ", block.Block.Header.Height, transactionId, operationId)
 
+	txKeys := []string{transactionId + "-" + operationId}
+
 	if operationId == "" {
-		operationId = "1"
+		// the event only identifies the transaction, new signatures are requested for all its bridge operations
+		koinosTxStore.Lock()
+		txKeys, _, err = GetKoinosTransactions(koinosTxStore, transactionId)
+		koinosTxStore.Unlock()
+
+		if err != nil {
+			log.Error(err.Error())
+			panic(err)
+		}
 	}
 
-	txKey := transactionId + "-" + operationId
-	koinosTxStore.Lock()
+	if len(txKeys) == 0 {
+		log.Infof("Koinos tx %s does not exist", transactionId)
+		return
+	}
+
+	for _, txKey := range txKeys {
+		requestNewKoinosSignatures(
+			ctx,
+			signingAuditLog,
+			source,
+			koinosTxStore,
+			blocktime,
+			signaturesExpiration,
+			ethPK,
+			ethereumAddress,
+			koinosPK,
+			koinosAddress,
+			evmChains,
+			validators,
+			txKey,
+		)
+	}
+}
+
+// requestNewKoinosSignatures signs again the bridge operation txKey with a new expiration
+func requestNewKoinosSignatures(
+	ctx context.Context,
+	signingAuditLog *store.SigningAuditLogStore,
+	source *bridge_pb.SigningAuditEntry,
+	koinosTxStore *store.TransactionsStore,
+	blocktime uint64,
+	signaturesExpiration uint,
+	ethPK *ecdsa.PrivateKey,
+	ethereumAddress string,
+	koinosPK []byte,
+	koinosAddress string,
+	evmChains EvmChains,
+	validators map[string]util.ValidatorConfig,
+	txKey string,
+) {
+	storeLock := newProcessorLock(koinosTxStore)
+	defer storeLock.releaseOnPanic()
+
+	newExpiration := blocktime + uint64(signaturesExpiration)
+
+	storeLock.Lock()
 	koinosTx, err := koinosTxStore.Get(txKey)
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
 
-	// if no other bridge unrelated operations are present in the transaction
-	// the opId is 1 or 3
-	if koinosTx == nil && operationId == "1" {
-		operationId = "3"
-		txKey = transactionId + "-" + operationId
-
-		koinosTx, err = koinosTxStore.Get(txKey)
-		if err != nil {
-			log.Error(err.Error())
-			panic(err)
-		}
+	if koinosTx == nil {
+		log.Infof("Koinos tx %s does not exist", txKey)
+		storeLock.Unlock()
+		return
 	}
 
-	if koinosTx != nil && koinosTx.Status != bridge_pb.TransactionStatus_completed {
+	if koinosTx.Status != bridge_pb.TransactionStatus_completed {
 		// can only request signatures after 2x expiration time
 		allowedRequestNewSignaturesBlockTime := koinosTx.Expiration + uint64(signaturesExpiration)
 
@@ -248,10 +470,19 @@ func processRequestNewSignaturesEvent(
 				panic(err)
 			}
 
+			evmChain := evmChains.ByChainId(koinosTx.ToChain)
+			if evmChain == nil {
+				log.Errorf("Koinos tx %s is sent to chain %s which is not configured", txKey, koinosTx.ToChain)
+				storeLock.Unlock()
+				return
+			}
+
 			// sign the transaction
-			_, prefixedHash := util.GenerateEthereumCompleteTransferHash(txId, opId, ethereumToken.Bytes(), recipient.Bytes(), relayer.Bytes(), koinosTx.Payment, koinosTx.Amount, ethereumContractAddr, koinosTx.Metadata, newExpiration, chain)
+			_, hashSpan := tracing.Start(ctx, "CompleteTransferHash")
+			_, prefixedHash, hashedData := util.GenerateEthereumCompleteTransferHash(txId, opId, ethereumToken.Bytes(), recipient.Bytes(), relayer.Bytes(), koinosTx.Payment, koinosTx.Amount, evmChain.ContractAddr, koinosTx.Metadata, newExpiration, chain)
+			hashSpan.End()
 
-			sigBytes := util.SignEthereumHash(ethPK, prefixedHash.Bytes())
+			sigBytes := util.SignEthereumHash(ctx, signingAuditLog, source, ethPK, hashedData, prefixedHash.Bytes())
 			sigHex := "0x" + common.Bytes2Hex(sigBytes)
 
 			// cleanup signatures
@@ -287,17 +518,17 @@ func processRequestNewSignaturesEvent(
 				koinosTx.Status = bridge_pb.TransactionStatus_signed
 			}
 
-			err = koinosTxStore.Put(txKey, koinosTx)
+			err = koinosTxStore.Update(ctx, txKey, koinosTx, transitionSource(bridge_pb.TransitionCause_streamer_event, source, ""))
 
 			if err != nil {
 				log.Error(err.Error())
 				panic(err)
 			}
 
-			koinosTxStore.Unlock()
+			storeLock.Unlock()
 
 			// broadcast transaction
-			koinosSignatures, _ := util.BroadcastTransaction(koinosTx, koinosPK, koinosAddress, validators)
+			koinosSignatures, _ := util.BroadcastTransaction(ctx, koinosTx, koinosPK, koinosAddress, validators, signingAuditLog, source)
 
 			// the signatures received from the broadcast are mapped using the Koinos validators addresses
 			// remap to Ethereum addresses
@@ -307,7 +538,7 @@ func processRequestNewSignaturesEvent(
 			}
 
 			// update the transaction with signatures we may have gotten back from the broadcast
-			koinosTxStore.Lock()
+			storeLock.Lock()
 
 			koinosTx, err = koinosTxStore.Get(txKey)
 			if err != nil {
@@ -334,26 +565,28 @@ func processRequestNewSignaturesEvent(
 				koinosTx.Status = bridge_pb.TransactionStatus_signed
 			}
 
-			err = koinosTxStore.Put(txKey, koinosTx)
+			err = koinosTxStore.Update(ctx, txKey, koinosTx, transitionSource(bridge_pb.TransitionCause_peer_submission, source, "broadcast responses"))
 
 			if err != nil {
 				log.Error(err.Error())
 				panic(err)
 			}
 
-			koinosTxStore.Unlock()
+			storeLock.Unlock()
 		} else {
-			log.Infof("Cannot request new signatures for Koinos tx %s / op id %s yet (current blocktime %d vs allowed blocktime %d)", transactionId, operationId, blocktime, allowedRequestNewSignaturesBlockTime)
-			koinosTxStore.Unlock()
+			log.Infof("Cannot request new signatures for Koinos tx %s yet (current blocktime %d vs allowed blocktime %d)", txKey, blocktime, allowedRequestNewSignaturesBlockTime)
+			storeLock.Unlock()
 		}
 	} else {
-		log.Infof("Koinos tx %s does not exist or is already completed", txKey)
-		koinosTxStore.Unlock()
+		log.Infof("Koinos tx %s is already completed", txKey)
+		storeLock.Unlock()
 	}
 }
 
 func processKoinosTransferCompletedEvent(
-	ethTxStore *store.TransactionsStore,
+	ctx context.Context,
+	source *bridge_pb.SigningAuditEntry,
+	evmChains EvmChains,
 	block *block_store.BlockItem,
 	receipt *protocol.TransactionReceipt,
 	event *protocol.EventData,
@@ -374,44 +607,77 @@ func processKoinosTransferCompletedEvent(
 
 	log.Infof("new Koinos transfer_completed_event | block: %s | eth tx: %s | koinos tx: %s | koinos op: %s", blockNumber, ethTxId, koinosTxId, koinosOpId)
 
-	ethTxStore.Lock()
-	ethTx, err := ethTxStore.Get(ethTxId)
+	// the event does not tell the chain of the transaction, look for it in all the chains
+	evmChain, _, _, err := evmChains.GetTransactions(ethTxId)
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
 
-	if ethTx == nil {
-		log.Warnf("ethereum transaction %s does not exist", ethTxId)
-		ethTx = &bridge_pb.Transaction{}
-		ethTx.Type = bridge_pb.TransactionType_ethereum
+	if evmChain == nil {
+		evmChain = evmChains[0]
 	}
 
-	ethTx.Status = bridge_pb.TransactionStatus_completed
-	ethTx.CompletionTransactionId = koinosTxId + "-" + koinosOpId
+	ethTxStore := evmChain.TxStore
+	storeLock := newProcessorLock(ethTxStore)
+	defer storeLock.releaseOnPanic()
 
-	err = ethTxStore.Put(ethTxId, ethTx)
+	storeLock.Lock()
+	txKeys, ethTxs, err := GetEthereumTransactions(ethTxStore, ethTxId)
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
-	ethTxStore.Unlock()
+
+	if len(ethTxs) == 0 {
+		// kept under the transaction id until the event is observed
+		log.Warnf("ethereum transaction %s does not exist", ethTxId)
+		txKeys = []string{ethTxId}
+		ethTxs = []*bridge_pb.Transaction{{Type: bridge_pb.TransactionType_ethereum}}
+	} else if len(ethTxs) > 1 {
+		// the Koinos contract identifies a transfer by its Ethereum transaction only, the event and the
+		// complete_transfer hash do not carry the log index, so the completed event cannot be told apart
+		log.Warnf("ethereum transaction %s has %d bridge events (%s), the completed one is unknown and their status is left unchanged", ethTxId, len(ethTxs), strings.Join(txKeys, ", "))
+		storeLock.Unlock()
+		return
+	}
+
+	for index, ethTx := range ethTxs {
+		ethTx.Status = bridge_pb.TransactionStatus_completed
+		ethTx.CompletionTransactionId = koinosTxId + "-" + koinosOpId
+
+		err = ethTxStore.Update(ctx, txKeys[index], ethTx, transitionSource(bridge_pb.TransitionCause_streamer_event, source, ""))
+		if err != nil {
+			log.Error(err.Error())
+			panic(err)
+		}
+	}
+	storeLock.Unlock()
 }
 
+// processKoinosTokensLockedEvent signs and stores the transfer of a tokens_locked_event,
+// it returns false when the destination chain is not configured and the event is not processed
 func processKoinosTokensLockedEvent(
+	ctx context.Context,
+	signingAuditLog *store.SigningAuditLogStore,
+	source *bridge_pb.SigningAuditEntry,
 	ethPK *ecdsa.PrivateKey,
 	ethereumAddress string,
 	koinosPK []byte,
 	koinosAddress string,
-	ethereumContractAddr common.Address,
-	tokenAddresses map[string]util.TokenConfig,
+	evmChains EvmChains,
 	koinosTxStore *store.TransactionsStore,
+	pendingTxStore *store.PendingTransactionsStore,
 	signaturesExpiration uint,
 	validators map[string]util.ValidatorConfig,
+	alertWebhook string,
 	block *block_store.BlockItem,
 	receipt *protocol.TransactionReceipt,
 	event *protocol.EventData,
-) {
+) bool {
+	storeLock := newProcessorLock(koinosTxStore)
+	defer storeLock.releaseOnPanic()
+
 	tokensLockedEvent := &bridge_pb.TokensLockedEvent{}
 
 	err := proto.Unmarshal(event.Data, tokensLockedEvent)
@@ -438,6 +704,15 @@ func processKoinosTokensLockedEvent(
 	chainId := tokensLockedEvent.ChainId
 	chainIdStr := fmt.Sprint(chainId)
 
+	evmChain := evmChains.ByChainId(chainIdStr)
+	if evmChain == nil {
+		util.SendAlert(alertWebhook, "tokens locked to a chain not configured", fmt.Sprintf("Koinos tx %s / op id %s is sent to chain %s which is not configured", txIdHex, operationIdStr, chainIdStr))
+		return false
+	}
+
+	ethereumContractAddr := evmChain.ContractAddr
+	tokenAddresses := evmChain.TokenAddresses
+
 	ethereumToken := common.HexToAddress(tokenAddresses[koinosToken].EthereumAddress)
 
 	log.Infof("new Koinos tokens_locked_event | block: %d | tx: %s | op_id: %s | Koinos token: %s | Ethereum token: %s | From: %s | recipient: %s | relayer: %s | payment: %s | amount: %s | metadata: %s  | chain: %s", blockNumber, txIdHex, operationIdStr, koinosToken, tokenAddresses[koinosToken].EthereumAddress, from, tokensLockedEvent.Recipient, tokensLockedEvent.Relayer, paymentStr, amountStr, tokensLockedEvent.Metadata, chainIdStr)
@@ -445,13 +720,15 @@ func processKoinosTokensLockedEvent(
 	expiration := blocktime + uint64(signaturesExpiration)
 
 	// sign the transaction
-	_, prefixedHash := util.GenerateEthereumCompleteTransferHash(txId, uint64(operationId), ethereumToken.Bytes(), recipient.Bytes(), relayer.Bytes(), payment, amount, ethereumContractAddr, metadata, expiration, uint64(chainId))
+	_, hashSpan := tracing.Start(ctx, "CompleteTransferHash")
+	_, prefixedHash, hashedData := util.GenerateEthereumCompleteTransferHash(txId, uint64(operationId), ethereumToken.Bytes(), recipient.Bytes(), relayer.Bytes(), payment, amount, ethereumContractAddr, metadata, expiration, uint64(chainId))
+	hashSpan.End()
 
-	sigBytes := util.SignEthereumHash(ethPK, prefixedHash.Bytes())
+	sigBytes := util.SignEthereumHash(ctx, signingAuditLog, source, ethPK, hashedData, prefixedHash.Bytes())
 	sigHex := "0x" + common.Bytes2Hex(sigBytes)
 
 	// store the transaction
-	koinosTxStore.Lock()
+	storeLock.Lock()
 
 	txKey := txIdHex + "-" + operationIdStr
 	koinosTx, err := koinosTxStore.Get(txKey)
@@ -470,8 +747,7 @@ func processKoinosTokensLockedEvent(
 			log.Errorf(errMsg)
 			panic(fmt.Errorf(errMsg))
 		}
-		koinosTx.Validators = append(koinosTx.Validators, ethereumAddress)
-		koinosTx.Signatures = append(koinosTx.Signatures, sigHex)
+		setValidatorSignature(koinosTx, ethereumAddress, sigHex)
 	}
 
 	koinosTx.Type = bridge_pb.TransactionType_koinos
@@ -494,17 +770,19 @@ func processKoinosTokensLockedEvent(
 		koinosTx.Status = bridge_pb.TransactionStatus_gathering_signatures
 	}
 
-	err = koinosTxStore.Put(txKey, koinosTx)
+	mergePendingTransaction(pendingTxStore, source.Chain, txKey, koinosTx)
+
+	err = koinosTxStore.Update(ctx, txKey, koinosTx, transitionSource(bridge_pb.TransitionCause_streamer_event, source, ""))
 
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
 
-	koinosTxStore.Unlock()
+	storeLock.Unlock()
 
 	// broadcast transaction
-	koinosSignatures, _ := util.BroadcastTransaction(koinosTx, koinosPK, koinosAddress, validators)
+	koinosSignatures, _ := util.BroadcastTransaction(ctx, koinosTx, koinosPK, koinosAddress, validators, signingAuditLog, source)
 
 	// the signatures received from the broadcast are mapped using the Koinos validators addresses
 	// remap to Ethereum addresses
@@ -514,7 +792,7 @@ func processKoinosTokensLockedEvent(
 	}
 
 	// update the transaction with signatures we may have gotten back from the broadcast
-	koinosTxStore.Lock()
+	storeLock.Lock()
 
 	koinosTx, err = koinosTxStore.Get(txKey)
 	if err != nil {
@@ -542,12 +820,13 @@ func processKoinosTokensLockedEvent(
 		koinosTx.Status = bridge_pb.TransactionStatus_signed
 	}
 
-	err = koinosTxStore.Put(txKey, koinosTx)
+	err = koinosTxStore.Update(ctx, txKey, koinosTx, transitionSource(bridge_pb.TransitionCause_peer_submission, source, "broadcast responses"))
 
 	if err != nil {
 		log.Error(err.Error())
 		panic(err)
 	}
 
-	koinosTxStore.Unlock()
+	storeLock.Unlock()
+	return true
 }
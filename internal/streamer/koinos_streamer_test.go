@@ -0,0 +1,110 @@
+package streamer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/koinos/koinos-proto-golang/koinos/protocol"
+	"github.com/koinos/koinos-proto-golang/koinos/rpc/block_store"
+	"github.com/mr-tron/base58"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+func TestKoinosTokensLockedToUnconfiguredChain(t *testing.T) {
+	koinosContract := bytes.Repeat([]byte{4}, 25)
+
+	data, err := proto.Marshal(&bridge_pb.TokensLockedEvent{
+		From:      bytes.Repeat([]byte{5}, 25),
+		Token:     bytes.Repeat([]byte{2}, 25),
+		Amount:    "1000",
+		Payment:   "10",
+		Relayer:   "0x00000000000000000000000000000000000000f1",
+		Recipient: "0x00000000000000000000000000000000000000f2",
+		ChainId:   7,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block := &block_store.BlockItem{
+		BlockHeight: 10,
+		Block:       &protocol.Block{Header: &protocol.BlockHeader{Timestamp: 1000}},
+	}
+	receipt := &protocol.TransactionReceipt{Id: []byte{1, 2}}
+	event := &protocol.EventData{Sequence: 1, Source: koinosContract, Name: "bridge.tokens_locked_event", Data: data}
+
+	ethereumPK, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	koinosTxStore := store.NewTransactionsStore(store.NewMapBackend())
+	processedEventsStore := store.NewProcessedEventsStore(store.NewMapBackend())
+	signingAuditLog, err := store.NewSigningAuditLogStore(store.NewMapBackend())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newProcessor := func(chainId uint64) *koinosEventsProcessor {
+		chain := NewEvmChain(&util.EvmChainConfig{
+			Name:     "ethereum",
+			ChainId:  chainId,
+			Contract: testContractAddr.Hex(),
+			Tokens: map[string]util.TokenConfig{
+				"token": {EthereumAddress: testEthToken.Hex(), KoinosAddress: testKoinosToken},
+			},
+		}, store.NewTransactionsStore(store.NewMapBackend()))
+
+		processor, err := newKoinosEventsProcessor(
+			ethereumPK,
+			crypto.PubkeyToAddress(ethereumPK.PublicKey).Hex(),
+			EvmChains{chain},
+			testKoinosPK,
+			"validator",
+			base58.Encode(koinosContract),
+			koinosTxStore,
+			processedEventsStore,
+			store.NewPendingTransactionsStore(store.NewMapBackend()),
+			signingAuditLog,
+			60000,
+			map[string]util.ValidatorConfig{},
+			"",
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return processor
+	}
+
+	eventKey := store.ProcessedEventKey(ChainKoinos, 10, "0x0102", 1)
+
+	// the chain 7 is not configured, the lock is neither signed nor recorded as processed
+	name, _ := newProcessor(5).processEvent(block, receipt, event)
+	if name != "" {
+		t.Fatalf("expected the event not to be processed, got %s", name)
+	}
+	if processedEvent, _ := processedEventsStore.Get(eventKey); processedEvent != nil {
+		t.Fatal("expected the event not to be recorded as processed")
+	}
+	if tx, _ := koinosTxStore.Get("0x0102-1"); tx != nil {
+		t.Fatal("expected the transfer not to be stored")
+	}
+
+	// once the chain is configured, the lock is processed
+	name, alreadyProcessed := newProcessor(7).processEvent(block, receipt, event)
+	if name != "bridge.tokens_locked_event" || alreadyProcessed {
+		t.Fatalf("expected the event to be processed, got %s, %v", name, alreadyProcessed)
+	}
+	if processedEvent, _ := processedEventsStore.Get(eventKey); processedEvent == nil {
+		t.Fatal("expected the event to be recorded as processed")
+	}
+	if tx, _ := koinosTxStore.Get("0x0102-1"); tx == nil || len(tx.Signatures) != 1 {
+		t.Fatalf("expected the transfer to be signed, got %v", tx)
+	}
+}
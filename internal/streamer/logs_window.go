@@ -0,0 +1,188 @@
+package streamer
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/koinos/koinos-log-golang"
+)
+
+const (
+	// consecutive successful requests after which the window grows again
+	logsWindowGrowthSuccesses = 4
+	// successful requests after which a window rejected by the provider may be tried again
+	logsWindowLimitExpiration = 1000
+)
+
+// errors returned by the providers when a FilterLogs request exceeds their limits (results count, block range, response size)
+var logsLimitErrors = []string{
+	"query returned more than",
+	"block range",
+	"range is too large",
+	"range too large",
+	"range too wide",
+	"exceed maximum block range",
+	"too many blocks",
+	"limit exceeded",
+	"response size exceeded",
+	"response size should not greater",
+	"too many results",
+	"query timeout exceeded",
+}
+
+// isLogsLimitError returns true if err is the rejection of a FilterLogs request exceeding the limits of the provider
+func isLogsLimitError(err error) bool {
+	msg := strings.ToLower(err.Error())
+
+	for _, limitErr := range logsLimitErrors {
+		if strings.Contains(msg, limitErr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LogsWindow sizes the block ranges of the FilterLogs requests sent to the provider of an EVM chain.
+// The window (number of blocks following the first block of a request) is bisected when the provider rejects
+// a request because of its limits and grows back after successes, up to the max blocks to stream of the chain.
+// The largest window accepted and the smallest window rejected by the provider are remembered so that
+// the window settles between them instead of being rejected again.
+type LogsWindow struct {
+	max       uint64
+	current   uint64
+	best      uint64
+	limit     uint64
+	limitAge  uint
+	successes uint
+	mutex     sync.Mutex
+}
+
+// NewLogsWindow creates a new LogsWindow starting at max
+func NewLogsWindow(max uint64) *LogsWindow {
+	return &LogsWindow{
+		max:     max,
+		current: max,
+	}
+}
+
+// Size returns the current window
+func (window *LogsWindow) Size() uint64 {
+	window.mutex.Lock()
+	defer window.mutex.Unlock()
+
+	return window.current
+}
+
+// Best returns the largest window accepted by the provider, 0 if none was yet
+func (window *LogsWindow) Best() uint64 {
+	window.mutex.Lock()
+	defer window.mutex.Unlock()
+
+	return window.best
+}
+
+// Accepted records a request of the given window accepted by the provider
+func (window *LogsWindow) Accepted(size uint64) {
+	window.mutex.Lock()
+	defer window.mutex.Unlock()
+
+	if size > window.best {
+		window.best = size
+	}
+
+	if window.limit > 0 && size >= window.limit {
+		window.limit = 0
+	}
+
+	// only requests of the current window count, smaller ones are the tail of a block range
+	if size < window.current {
+		return
+	}
+
+	if window.limit > 0 {
+		window.limitAge++
+		if window.limitAge >= logsWindowLimitExpiration {
+			window.limit = 0
+		}
+	}
+
+	window.successes++
+	if window.successes < logsWindowGrowthSuccesses || window.current == window.max {
+		return
+	}
+
+	window.successes = 0
+
+	next := window.current*2 + 1
+	if window.current < window.best {
+		next = window.best
+	} else if window.limit > 0 {
+		next = (window.current + window.limit) / 2
+	}
+
+	if next > window.max {
+		next = window.max
+	}
+
+	window.current = next
+}
+
+// Rejected records a request of the given window rejected because of the limits of the provider,
+// it returns false if the window cannot be reduced anymore
+func (window *LogsWindow) Rejected(size uint64) bool {
+	window.mutex.Lock()
+	defer window.mutex.Unlock()
+
+	if size == 0 {
+		return false
+	}
+
+	window.successes = 0
+	if window.limit == 0 || size < window.limit {
+		window.limit = size
+		window.limitAge = 0
+	}
+
+	// the provider does not accept the best window anymore (e.g. more events in the recent blocks)
+	if window.best >= size {
+		window.best = size / 2
+	}
+
+	next := size / 2
+	if window.best > next {
+		next = window.best
+	}
+
+	if next < window.current {
+		window.current = next
+	}
+
+	return true
+}
+
+// filterLogs fetches the bridge events emitted from fromBlock, up to toBlock, in a single FilterLogs request.
+// When the provider rejects the request because of its limits, the range is bisected until it is accepted,
+// the last block covered by the logs returned is returned with them.
+func filterLogs(ctx context.Context, ethCl *EvmClient, chain *EvmChain, fromBlock uint64, toBlock uint64) ([]types.Log, uint64, error) {
+	for {
+		if chain.LogsWindow.Size() < toBlock-fromBlock {
+			toBlock = fromBlock + chain.LogsWindow.Size()
+		}
+
+		size := toBlock - fromBlock
+		logs, err := ethCl.FilterLogs(ctx, ethereumEventsFilterQuery(chain.ContractAddr, fromBlock, toBlock))
+		if err == nil {
+			chain.LogsWindow.Accepted(size)
+			return logs, toBlock, nil
+		}
+
+		if ctx.Err() != nil || !isLogsLimitError(err) || !chain.LogsWindow.Rejected(size) {
+			return nil, toBlock, err
+		}
+
+		log.Warnf("%s logs %d - %d exceed the provider limits, reducing the window to %d blocks: %s", chain.Name, fromBlock, toBlock, chain.LogsWindow.Size(), err.Error())
+	}
+}
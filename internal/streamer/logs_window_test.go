@@ -0,0 +1,93 @@
+package streamer
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFilterLogsBisectsOnLimitError(t *testing.T) {
+	rpc := &fakeEvmRPC{head: 1000, maxRange: 30}
+	server := httptest.NewServer(rpc)
+	defer server.Close()
+
+	chain := newTestEvmChain(server.URL, FinalityConfirmations, 0)
+	ethCl, err := DialEvmChain(context.Background(), chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ethCl.Close()
+
+	// 100 and 50 blocks are rejected, 25 are accepted
+	_, toBlock, err := filterLogs(context.Background(), ethCl, chain, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if toBlock != 25 {
+		t.Fatalf("expected the range to be halved down to block 25, got %d", toBlock)
+	}
+	rpc.mutex.Lock()
+	ranges := rpc.ranges
+	rpc.mutex.Unlock()
+	if len(ranges) != 3 || ranges[0][1] != 100 || ranges[1][1] != 50 {
+		t.Fatalf("expected the rejected ranges to be halved, got %v", ranges)
+	}
+	if chain.LogsWindow.Size() != 25 || chain.LogsWindow.Best() != 25 {
+		t.Fatalf("expected a window of 25 blocks, got %d (best %d)", chain.LogsWindow.Size(), chain.LogsWindow.Best())
+	}
+
+	// the provider accepts any range again, the window grows but stays below the range rejected
+	rpc.mutex.Lock()
+	rpc.maxRange = 0
+	rpc.mutex.Unlock()
+
+	fromBlock := toBlock
+	for index := 0; index < logsWindowGrowthSuccesses*10; index++ {
+		_, toBlock, err = filterLogs(context.Background(), ethCl, chain, fromBlock, fromBlock+100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fromBlock = toBlock
+	}
+
+	size := chain.LogsWindow.Size()
+	if size <= 25 || size >= 50 {
+		t.Fatalf("expected the window to grow up to the range rejected, got %d", size)
+	}
+
+	// the range rejected is forgotten after logsWindowLimitExpiration successes, the window grows up to max-blocks-stream
+	for index := 0; index < logsWindowLimitExpiration; index++ {
+		chain.LogsWindow.Accepted(chain.LogsWindow.Size())
+	}
+	if chain.LogsWindow.Size() != chain.MaxBlocksToStream {
+		t.Fatalf("expected the window to grow back to %d, got %d", chain.MaxBlocksToStream, chain.LogsWindow.Size())
+	}
+}
+
+func TestFilterLogsOtherErrors(t *testing.T) {
+	rpc := &fakeEvmRPC{head: 1000}
+	server := httptest.NewServer(rpc)
+
+	chain := newTestEvmChain(server.URL, FinalityConfirmations, 0)
+	ethCl, err := DialEvmChain(context.Background(), chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ethCl.Close()
+
+	// an error not caused by the limits of the provider does not change the window
+	server.Close()
+
+	_, _, err = filterLogs(context.Background(), ethCl, chain, 0, 100)
+	if err == nil {
+		t.Fatal("expected the request to fail")
+	}
+	if chain.LogsWindow.Size() != 100 {
+		t.Fatalf("expected the window to be unchanged, got %d", chain.LogsWindow.Size())
+	}
+
+	if isLogsLimitError(errors.New("connection refused")) || !isLogsLimitError(errors.New("Query returned more than 10000 results")) {
+		t.Fatal("unexpected limit error detection")
+	}
+}
@@ -0,0 +1,130 @@
+package streamer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// MigrateEthereumTransactionKeys moves the transactions of an EVM chain stored under their id alone to the key including
+// the log index of their TokensLockedEvent. The log index is found in the processed events or, for the events processed
+// before they were recorded, in the transaction receipt. The transactions completed before being observed have no event
+// to look for and stay under their id until the event is observed.
+func MigrateEthereumTransactionKeys(ctx context.Context, chain *EvmChain, processedEventsStore *store.ProcessedEventsStore) error {
+	chain.TxStore.Lock()
+	defer chain.TxStore.Unlock()
+
+	keys := []string{}
+	txs := []*bridge_pb.Transaction{}
+
+	err := chain.TxStore.Iterate(func(key string, tx *bridge_pb.Transaction) error {
+		if !strings.Contains(key, "-") && tx.BlockNumber != 0 {
+			keys = append(keys, key)
+			txs = append(txs, tx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	log.Infof("migrating %d %s transactions to keys including the log index", len(keys), chain.Name)
+
+	var ethCl *ethclient.Client
+	defer func() {
+		if ethCl != nil {
+			ethCl.Close()
+		}
+	}()
+
+	for index, txId := range keys {
+		tx := txs[index]
+
+		logIndex, found, err := processedTokensLockedLogIndex(processedEventsStore, chain.Name, tx.BlockNumber, txId)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			if ethCl == nil {
+				ethCl, err = ethclient.DialContext(ctx, chain.Rpc)
+				if err != nil {
+					return err
+				}
+			}
+
+			logIndex, found, err = receiptTokensLockedLogIndex(ctx, ethCl, chain, txId)
+			if err != nil {
+				log.Warnf("cannot get the receipt of %s tx %s: %s", chain.Name, txId, err.Error())
+			}
+		}
+
+		if !found {
+			log.Warnf("cannot find the TokensLockedEvent of %s tx %s, the transaction stays under its id", chain.Name, txId)
+			continue
+		}
+
+		txKey := EthereumTransactionKey(txId, logIndex)
+		tx.OpId = fmt.Sprint(logIndex)
+
+		err = chain.TxStore.Put(txKey, tx)
+		if err != nil {
+			return err
+		}
+
+		err = chain.TxStore.Delete(txId)
+		if err != nil {
+			return err
+		}
+
+		log.Infof("migrated %s tx %s to %s", chain.Name, txId, txKey)
+	}
+
+	return nil
+}
+
+// processedTokensLockedLogIndex returns the log index of the TokensLockedEvent processed for the transaction txId
+func processedTokensLockedLogIndex(processedEventsStore *store.ProcessedEventsStore, chainName string, blockNumber uint64, txId string) (uint64, bool, error) {
+	var logIndex uint64
+	found := false
+
+	err := processedEventsStore.IteratePrefix(store.ProcessedTransactionEventsPrefix(chainName, blockNumber, txId), func(key string, event *bridge_pb.ProcessedEvent) error {
+		if !found && event.Name == "TokensLockedEvent" {
+			logIndex = event.Index
+			found = true
+		}
+
+		return nil
+	})
+
+	return logIndex, found, err
+}
+
+// receiptTokensLockedLogIndex returns the log index of the first TokensLockedEvent emitted by the bridge contract
+// in the transaction txId, the only one that could be stored under the transaction id
+func receiptTokensLockedLogIndex(ctx context.Context, ethCl *ethclient.Client, chain *EvmChain, txId string) (uint64, bool, error) {
+	receipt, err := ethCl.TransactionReceipt(ctx, common.HexToHash(txId))
+	if err != nil {
+		return 0, false, err
+	}
+
+	for _, vLog := range receipt.Logs {
+		if vLog.Address == chain.ContractAddr && len(vLog.Topics) > 0 && vLog.Topics[0] == tokensLockedEventTopic {
+			return uint64(vLog.Index), true, nil
+		}
+	}
+
+	return 0, false, nil
+}
@@ -0,0 +1,366 @@
+package streamer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	log "github.com/koinos/koinos-log-golang"
+	"github.com/koinos/koinos-proto-golang/koinos/protocol"
+	kjsonrpc "github.com/koinos/koinos-util-golang/rpc"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/rpc"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+const (
+	RescanStatusRunning   = "running"
+	RescanStatusCompleted = "completed"
+	RescanStatusFailed    = "failed"
+)
+
+// RescanEvent is a bridge event found while rescanning a block range
+type RescanEvent struct {
+	Name          string `json:"name"`
+	BlockNumber   uint64 `json:"blockNumber"`
+	TransactionId string `json:"transactionId"`
+	// log index for Ethereum, event sequence for Koinos
+	Index            uint64 `json:"index"`
+	AlreadyProcessed bool   `json:"alreadyProcessed"`
+}
+
+// RescanJob describes the rescan of a historical block range
+type RescanJob struct {
+	Id              string         `json:"id"`
+	Chain           string         `json:"chain"`
+	FromBlock       uint64         `json:"fromBlock"`
+	ToBlock         uint64         `json:"toBlock"`
+	LastBlockParsed uint64         `json:"lastBlockParsed"`
+	Status          string         `json:"status"`
+	Error           string         `json:"error"`
+	StartedAt       int64          `json:"startedAt"`
+	CompletedAt     int64          `json:"completedAt"`
+	Events          []*RescanEvent `json:"events"`
+}
+
+// Rescanner rescans historical block ranges in separate workers, without moving the streamers checkpoints
+type Rescanner struct {
+	ctx context.Context
+
+	evmChains     EvmChains
+	ethProcessors map[string]*ethereumEventsProcessor
+
+	koinosProcessor         *koinosEventsProcessor
+	koinosRPC               string
+	koinosMaxBlocksToStream uint64
+
+	jobs      map[string]*RescanJob
+	lastJobId uint64
+	mutex     sync.Mutex
+}
+
+// NewRescanner creates a new Rescanner, the rescans are cancelled when ctx is done
+func NewRescanner(
+	ctx context.Context,
+	evmChains EvmChains,
+	ethereumPK *ecdsa.PrivateKey,
+	ethereumAddress string,
+	koinosRPC string,
+	koinosMaxBlocksToStream uint64,
+	koinosPK []byte,
+	koinosAddress string,
+	koinosContractStr string,
+	koinosTxStore *store.TransactionsStore,
+	processedEventsStore *store.ProcessedEventsStore,
+	pendingTxStore *store.PendingTransactionsStore,
+	signingAuditLog *store.SigningAuditLogStore,
+	signaturesExpiration uint,
+	validators map[string]util.ValidatorConfig,
+	alertWebhook string,
+) (*Rescanner, error) {
+	ethProcessors, koinosProcessor, err := newEventsProcessors(
+		evmChains,
+		ethereumPK,
+		ethereumAddress,
+		koinosPK,
+		koinosAddress,
+		koinosContractStr,
+		koinosTxStore,
+		processedEventsStore,
+		pendingTxStore,
+		signingAuditLog,
+		signaturesExpiration,
+		validators,
+		alertWebhook,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rescanner{
+		ctx:                     ctx,
+		evmChains:               evmChains,
+		ethProcessors:           ethProcessors,
+		koinosProcessor:         koinosProcessor,
+		koinosRPC:               koinosRPC,
+		koinosMaxBlocksToStream: koinosMaxBlocksToStream,
+		jobs:                    make(map[string]*RescanJob),
+	}, nil
+}
+
+// newEventsProcessors creates the events processors of the EVM chains, by chain name, and of Koinos
+func newEventsProcessors(
+	evmChains EvmChains,
+	ethereumPK *ecdsa.PrivateKey,
+	ethereumAddress string,
+	koinosPK []byte,
+	koinosAddress string,
+	koinosContractStr string,
+	koinosTxStore *store.TransactionsStore,
+	processedEventsStore *store.ProcessedEventsStore,
+	pendingTxStore *store.PendingTransactionsStore,
+	signingAuditLog *store.SigningAuditLogStore,
+	signaturesExpiration uint,
+	validators map[string]util.ValidatorConfig,
+	alertWebhook string,
+) (map[string]*ethereumEventsProcessor, *koinosEventsProcessor, error) {
+	ethProcessors := make(map[string]*ethereumEventsProcessor)
+
+	for _, chain := range evmChains {
+		ethProcessor, err := newEthereumEventsProcessor(
+			chain,
+			koinosPK,
+			koinosAddress,
+			koinosContractStr,
+			koinosTxStore,
+			processedEventsStore,
+			pendingTxStore,
+			signingAuditLog,
+			signaturesExpiration,
+			validators,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		ethProcessors[chain.Name] = ethProcessor
+	}
+
+	koinosProcessor, err := newKoinosEventsProcessor(
+		ethereumPK,
+		ethereumAddress,
+		evmChains,
+		koinosPK,
+		koinosAddress,
+		koinosContractStr,
+		koinosTxStore,
+		processedEventsStore,
+		pendingTxStore,
+		signingAuditLog,
+		signaturesExpiration,
+		validators,
+		alertWebhook,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ethProcessors, koinosProcessor, nil
+}
+
+// Start starts the rescan of the blocks fromBlock to toBlock (inclusive) of chain, koinos or the name of an EVM chain
+func (rescanner *Rescanner) Start(chain string, fromBlock uint64, toBlock uint64) (*RescanJob, error) {
+	if chain != ChainKoinos && rescanner.evmChains.Get(chain) == nil {
+		return nil, fmt.Errorf("invalid chain %s", chain)
+	}
+
+	if fromBlock == 0 || toBlock < fromBlock {
+		return nil, fmt.Errorf("invalid block range %d - %d", fromBlock, toBlock)
+	}
+
+	rescanner.mutex.Lock()
+	rescanner.lastJobId++
+	job := &RescanJob{
+		Id:        strconv.FormatUint(rescanner.lastJobId, 10),
+		Chain:     chain,
+		FromBlock: fromBlock,
+		ToBlock:   toBlock,
+		Status:    RescanStatusRunning,
+		StartedAt: time.Now().UnixMilli(),
+		Events:    []*RescanEvent{},
+	}
+	rescanner.jobs[job.Id] = job
+	jobCopy := copyRescanJob(job)
+	rescanner.mutex.Unlock()
+
+	go rescanner.run(job)
+
+	return jobCopy, nil
+}
+
+// Get returns a snapshot of the rescan job id, nil if it does not exist
+func (rescanner *Rescanner) Get(id string) *RescanJob {
+	rescanner.mutex.Lock()
+	defer rescanner.mutex.Unlock()
+
+	job, found := rescanner.jobs[id]
+	if !found {
+		return nil
+	}
+
+	return copyRescanJob(job)
+}
+
+func copyRescanJob(job *RescanJob) *RescanJob {
+	jobCopy := *job
+	jobCopy.Events = append([]*RescanEvent{}, job.Events...)
+
+	return &jobCopy
+}
+
+func (rescanner *Rescanner) run(job *RescanJob) {
+	log.Infof("start rescan %s of %s blocks %d - %d", job.Id, job.Chain, job.FromBlock, job.ToBlock)
+
+	var err error
+
+	if job.Chain == ChainKoinos {
+		err = rescanner.rescanKoinos(job)
+	} else {
+		err = rescanner.rescanEthereum(job)
+	}
+
+	rescanner.mutex.Lock()
+	defer rescanner.mutex.Unlock()
+
+	job.CompletedAt = time.Now().UnixMilli()
+
+	if err != nil {
+		log.Errorf("rescan %s failed: %s", job.Id, err.Error())
+		job.Status = RescanStatusFailed
+		job.Error = err.Error()
+	} else {
+		log.Infof("rescan %s completed, %d events found", job.Id, len(job.Events))
+		job.Status = RescanStatusCompleted
+	}
+}
+
+func (rescanner *Rescanner) addEvents(job *RescanJob, lastBlockParsed uint64, events []*RescanEvent) {
+	rescanner.mutex.Lock()
+	defer rescanner.mutex.Unlock()
+
+	job.LastBlockParsed = lastBlockParsed
+	job.Events = append(job.Events, events...)
+}
+
+func (rescanner *Rescanner) rescanEthereum(job *RescanJob) error {
+	chain := rescanner.evmChains.Get(job.Chain)
+	ethProcessor := rescanner.ethProcessors[job.Chain]
+
+	ethCl, err := DialEvmChain(rescanner.ctx, chain)
+	if err != nil {
+		return err
+	}
+	defer ethCl.Close()
+
+	lastFinalBlock, err := ethCl.LastFinalBlock(rescanner.ctx)
+	if err != nil {
+		return err
+	}
+
+	if job.ToBlock > lastFinalBlock {
+		return fmt.Errorf("block %d is not final yet (%s)", job.ToBlock, chain.FinalityStr())
+	}
+
+	for fromBlock := job.FromBlock; fromBlock <= job.ToBlock; {
+		if rescanner.ctx.Err() != nil {
+			return errors.New("rescan cancelled")
+		}
+
+		toBlock := fromBlock + chain.MaxBlocksToStream
+		if toBlock > job.ToBlock {
+			toBlock = job.ToBlock
+		}
+
+		logs, toBlock, err := filterLogs(rescanner.ctx, ethCl, chain, fromBlock, toBlock)
+		if err != nil {
+			return err
+		}
+
+		events := []*RescanEvent{}
+		for _, vLog := range logs {
+			name, alreadyProcessed := ethProcessor.processLog(vLog)
+			if name != "" {
+				events = append(events, &RescanEvent{
+					Name:             name,
+					BlockNumber:      vLog.BlockNumber,
+					TransactionId:    vLog.TxHash.Hex(),
+					Index:            uint64(vLog.Index),
+					AlreadyProcessed: alreadyProcessed,
+				})
+			}
+		}
+
+		rescanner.addEvents(job, toBlock, events)
+		fromBlock = toBlock + 1
+	}
+
+	return nil
+}
+
+func (rescanner *Rescanner) rescanKoinos(job *RescanJob) error {
+	rpcClient := rpc.NewJsonRPC(kjsonrpc.NewKoinosRPCClient(rescanner.koinosRPC))
+
+	headInfo, err := rpcClient.GetHeadInfo(rescanner.ctx)
+	if err != nil {
+		return err
+	}
+
+	if job.ToBlock > headInfo.LastIrreversibleBlock {
+		return fmt.Errorf("block %d is not irreversible yet", job.ToBlock)
+	}
+
+	for fromBlock := job.FromBlock; fromBlock <= job.ToBlock; {
+		if rescanner.ctx.Err() != nil {
+			return errors.New("rescan cancelled")
+		}
+
+		nbBlocksToFetch := job.ToBlock - fromBlock + 1
+		if nbBlocksToFetch > rescanner.koinosMaxBlocksToStream {
+			nbBlocksToFetch = rescanner.koinosMaxBlocksToStream
+		}
+
+		blocks, err := rpcClient.GetBlocksByHeight(rescanner.ctx, headInfo.HeadTopology.Id, fromBlock, uint32(nbBlocksToFetch))
+		if err != nil {
+			return err
+		}
+
+		if len(blocks.BlockItems) == 0 {
+			return fmt.Errorf("no blocks returned from block %d", fromBlock)
+		}
+
+		events := []*RescanEvent{}
+		for _, block := range blocks.BlockItems {
+			rescanner.koinosProcessor.processBlock(block, func(receipt *protocol.TransactionReceipt, event *protocol.EventData, name string, alreadyProcessed bool) {
+				events = append(events, &RescanEvent{
+					Name:             name,
+					BlockNumber:      block.BlockHeight,
+					TransactionId:    "0x" + common.Bytes2Hex(receipt.Id),
+					Index:            uint64(event.Sequence),
+					AlreadyProcessed: alreadyProcessed,
+				})
+			})
+
+			fromBlock = block.BlockHeight + 1
+		}
+
+		rescanner.addEvents(job, fromBlock-1, events)
+	}
+
+	return nil
+}
@@ -0,0 +1,460 @@
+package streamer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	log "github.com/koinos/koinos-log-golang"
+	"github.com/koinos/koinos-proto-golang/koinos/protocol"
+	koinosUtil "github.com/koinos/koinos-util-golang"
+	kjsonrpc "github.com/koinos/koinos-util-golang/rpc"
+	"github.com/mr-tron/base58"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+const (
+	// entry point of the "request_new_signatures" method of the Koinos bridge contract
+	koinosRequestNewSignaturesEntryPoint = 0x97bbe8a6
+
+	requestNewSignaturesAbiStr = `[{
+	"inputs": [
+	  {
+		"internalType": "bytes",
+		"name": "txId",
+		"type": "bytes"
+	  }
+	],
+	"name": "requestNewSignatures",
+	"outputs": [],
+	"stateMutability": "nonpayable",
+	"type": "function"
+  }]`
+
+	gwei = 1000000000
+
+	// maximum wait of the receipt of an Ethereum request
+	ethereumReceiptTimeout = 5 * time.Minute
+)
+
+// SignaturesRequester submits request_new_signatures to the source contract of the expired transfers not completed,
+// once new signatures can be requested, so that the validators sign them again. The requests are paid by keys
+// distinct from the signing keys, within daily spending limits, with a limited number of attempts per transfer.
+type SignaturesRequester struct {
+	evmChains            EvmChains
+	koinosTxStore        *store.TransactionsStore
+	koinosRPC            string
+	koinosContractAddr   []byte
+	signaturesExpiration uint
+	config               util.SignaturesRequesterConfig
+
+	// nil when the requests of the corresponding chains are not submitted
+	ethereumPK *ecdsa.PrivateKey
+	koinosKey  *koinosUtil.KoinosKey
+
+	requestNewSignaturesAbi abi.ABI
+
+	// the attempts per transfer and expiration, and the daily spending per chain, are persisted in the metadata
+	// so that a restart does not reset the limits
+	metadataStore *store.MetadataStore
+	attempts      map[string]*bridge_pb.SignaturesRequestAttempts
+	spent         map[string]*bridge_pb.SignaturesRequestSpending
+	mutex         sync.Mutex
+}
+
+// NewSignaturesRequester creates a new SignaturesRequester
+func NewSignaturesRequester(
+	evmChains EvmChains,
+	koinosTxStore *store.TransactionsStore,
+	metadataStore *store.MetadataStore,
+	koinosRPC string,
+	koinosContractStr string,
+	signaturesExpiration uint,
+	config util.SignaturesRequesterConfig,
+	ethereumPK *ecdsa.PrivateKey,
+	koinosKey *koinosUtil.KoinosKey,
+) (*SignaturesRequester, error) {
+	requestNewSignaturesAbi, err := abi.JSON(strings.NewReader(requestNewSignaturesAbiStr))
+	if err != nil {
+		return nil, err
+	}
+
+	koinosContractAddr, err := base58.Decode(koinosContractStr)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataStore.Lock()
+	metadata, err := metadataStore.Get()
+	metadataStore.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := metadata.SignaturesRequestAttempts
+	if attempts == nil {
+		attempts = make(map[string]*bridge_pb.SignaturesRequestAttempts)
+	}
+
+	spent := metadata.SignaturesRequestSpending
+	if spent == nil {
+		spent = make(map[string]*bridge_pb.SignaturesRequestSpending)
+	}
+
+	return &SignaturesRequester{
+		evmChains:               evmChains,
+		koinosTxStore:           koinosTxStore,
+		koinosRPC:               koinosRPC,
+		koinosContractAddr:      koinosContractAddr,
+		signaturesExpiration:    signaturesExpiration,
+		config:                  config,
+		ethereumPK:              ethereumPK,
+		koinosKey:               koinosKey,
+		requestNewSignaturesAbi: requestNewSignaturesAbi,
+		metadataStore:           metadataStore,
+		attempts:                attempts,
+		spent:                   spent,
+	}, nil
+}
+
+// Run submits the requests every interval ms until ctx is done
+func (requester *SignaturesRequester) Run(wg *sync.WaitGroup, ctx context.Context, interval uint) {
+	defer wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("stop signatures requester")
+			return
+
+		case <-time.After(time.Millisecond * time.Duration(interval)):
+			requester.RequestNewSignatures(ctx)
+		}
+	}
+}
+
+// signaturesRequest is a request of new signatures to submit, chain is nil for a Koinos transfer
+type signaturesRequest struct {
+	chain *EvmChain
+	key   string
+	txId  string
+	opId  string
+}
+
+// RequestNewSignatures submits the requests of the expired transfers whose new signatures can be requested.
+// The requests are collected while iterating the transactions and only submitted once the iterations are done,
+// so that the transactions stores are not locked while waiting for the requests to be mined.
+func (requester *SignaturesRequester) RequestNewSignatures(ctx context.Context) {
+	now := time.Now()
+
+	// attempts of the transfers still expired, the others are pruned once all the transfers are iterated
+	expired := make(map[string]bool)
+	complete := true
+	requests := []signaturesRequest{}
+
+	if requester.ethereumPK != nil {
+		for _, chain := range requester.evmChains {
+			// the Ethereum requests identify the transaction only, all its bridge events are signed again
+			requested := make(map[string]bool)
+
+			err := chain.TxStore.Iterate(func(key string, tx *bridge_pb.Transaction) error {
+				if requested[tx.Id] || !requester.mustRequest(chain.Name+"-"+tx.Id, tx, now, expired) {
+					return nil
+				}
+				requested[tx.Id] = true
+				requests = append(requests, signaturesRequest{chain: chain, key: key, txId: tx.Id})
+
+				return nil
+			})
+			if err != nil {
+				complete = false
+				log.Errorf("cannot request new signatures for %s transactions: %s", chain.Name, err.Error())
+			}
+		}
+	}
+
+	if requester.koinosKey != nil {
+		err := requester.koinosTxStore.Iterate(func(key string, tx *bridge_pb.Transaction) error {
+			if !requester.mustRequest(ChainKoinos+"-"+key, tx, now, expired) {
+				return nil
+			}
+			requests = append(requests, signaturesRequest{key: key, txId: tx.Id, opId: tx.OpId})
+
+			return nil
+		})
+		if err != nil {
+			complete = false
+			log.Errorf("cannot request new signatures for Koinos transactions: %s", err.Error())
+		}
+	}
+
+	if complete {
+		requester.pruneAttempts(expired)
+	}
+
+	for _, request := range requests {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if request.chain != nil {
+			err := requester.requestEthereumSignatures(ctx, request.chain, request.txId)
+			if err != nil {
+				log.Errorf("cannot request new signatures for %s tx %s: %s", request.chain.Name, request.txId, err.Error())
+			}
+		} else {
+			err := requester.requestKoinosSignatures(ctx, request.txId, request.opId)
+			if err != nil {
+				log.Errorf("cannot request new signatures for Koinos tx %s: %s", request.key, err.Error())
+			}
+		}
+	}
+}
+
+// mustRequest returns true if a request must be submitted for the transfer and records the attempt,
+// the attempts of a transfer are counted per expiration so that each expiration gets its attempts.
+// The key of the attempts of the expired transfers is added to expired.
+func (requester *SignaturesRequester) mustRequest(transfer string, tx *bridge_pb.Transaction, now time.Time, expired map[string]bool) bool {
+	if tx.Status != bridge_pb.TransactionStatus_expired {
+		return false
+	}
+
+	key := fmt.Sprintf("%s-%d", transfer, tx.Expiration)
+	expired[key] = true
+
+	if uint64(now.UnixMilli()) < tx.Expiration+uint64(requester.signaturesExpiration) {
+		return false
+	}
+
+	requester.mutex.Lock()
+	defer requester.mutex.Unlock()
+
+	attempts, found := requester.attempts[key]
+	if !found {
+		attempts = &bridge_pb.SignaturesRequestAttempts{}
+		requester.attempts[key] = attempts
+	}
+
+	if attempts.Count >= uint64(requester.config.MaxAttempts) ||
+		(attempts.Count > 0 && uint64(now.UnixMilli()) < attempts.Last+uint64(requester.config.RetryDelay)) {
+		return false
+	}
+
+	attempts.Count++
+	attempts.Last = uint64(now.UnixMilli())
+	requester.persist()
+
+	log.Infof("requesting new signatures for %s (attempt %d/%d)", transfer, attempts.Count, requester.config.MaxAttempts)
+	return true
+}
+
+// pruneAttempts forgets the attempts of the transfers not expired anymore, or expired again since
+func (requester *SignaturesRequester) pruneAttempts(expired map[string]bool) {
+	requester.mutex.Lock()
+	defer requester.mutex.Unlock()
+
+	pruned := false
+	for key := range requester.attempts {
+		if !expired[key] {
+			delete(requester.attempts, key)
+			pruned = true
+		}
+	}
+
+	if pruned {
+		requester.persist()
+	}
+}
+
+// persist saves the attempts and the spending in the metadata, the requester mutex must be locked
+func (requester *SignaturesRequester) persist() {
+	requester.metadataStore.Lock()
+	defer requester.metadataStore.Unlock()
+
+	metadata, err := requester.metadataStore.Get()
+	if err == nil {
+		metadata.SignaturesRequestAttempts = requester.attempts
+		metadata.SignaturesRequestSpending = requester.spent
+		err = requester.metadataStore.Put(metadata)
+	}
+
+	if err != nil {
+		log.Errorf("cannot save the signatures requests attempts and spending: %s", err.Error())
+	}
+}
+
+// spend reserves amount in the daily spending of chain, it returns false if the daily limit would be exceeded
+func (requester *SignaturesRequester) spend(chain string, amount uint64, limit uint64) bool {
+	requester.mutex.Lock()
+	defer requester.mutex.Unlock()
+
+	day := time.Now().Unix() / (24 * 60 * 60)
+
+	spent, found := requester.spent[chain]
+	if !found || spent.Day != day {
+		spent = &bridge_pb.SignaturesRequestSpending{Day: day}
+		requester.spent[chain] = spent
+	}
+
+	if spent.Amount+amount > limit {
+		return false
+	}
+
+	spent.Amount += amount
+	requester.persist()
+	return true
+}
+
+// refund gives back to the daily spending of chain what was reserved but not spent
+func (requester *SignaturesRequester) refund(chain string, amount uint64) {
+	requester.mutex.Lock()
+	defer requester.mutex.Unlock()
+
+	spent, found := requester.spent[chain]
+	if !found {
+		return
+	}
+
+	if amount > spent.Amount {
+		amount = spent.Amount
+	}
+	spent.Amount -= amount
+	requester.persist()
+}
+
+// requestEthereumSignatures calls requestNewSignatures on the bridge contract of the EVM chain for the transaction txId
+func (requester *SignaturesRequester) requestEthereumSignatures(ctx context.Context, chain *EvmChain, txId string) error {
+	data, err := requester.requestNewSignaturesAbi.Pack("requestNewSignatures", common.FromHex(txId))
+	if err != nil {
+		return err
+	}
+
+	ethCl, err := ethclient.DialContext(ctx, chain.Rpc)
+	if err != nil {
+		return err
+	}
+	defer ethCl.Close()
+
+	gasPrice, err := ethCl.SuggestGasPrice(ctx)
+	if err != nil {
+		return err
+	}
+
+	maxGasPrice := new(big.Int).Mul(new(big.Int).SetUint64(requester.config.EthereumMaxGasPrice), big.NewInt(gwei))
+	if gasPrice.Cmp(maxGasPrice) > 0 {
+		return fmt.Errorf("gas price %s above the limit of %d gwei", gasPrice.String(), requester.config.EthereumMaxGasPrice)
+	}
+
+	// the transaction costs at most the gas limit at the gas price, in gwei rounded up
+	cost := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(requester.config.EthereumGasLimit))
+	costGwei := new(big.Int).Div(new(big.Int).Add(cost, big.NewInt(gwei-1)), big.NewInt(gwei)).Uint64()
+
+	if !requester.spend(chain.Name, costGwei, requester.config.EthereumDailyLimit) {
+		return fmt.Errorf("daily spending limit of %d gwei reached", requester.config.EthereumDailyLimit)
+	}
+
+	from := crypto.PubkeyToAddress(requester.ethereumPK.PublicKey)
+	signedTx, err := func() (*types.Transaction, error) {
+		nonce, err := ethCl.PendingNonceAt(ctx, from)
+		if err != nil {
+			return nil, err
+		}
+
+		chainId, err := ethCl.ChainID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		tx := types.NewTransaction(nonce, chain.ContractAddr, big.NewInt(0), requester.config.EthereumGasLimit, gasPrice, data)
+		signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(chainId), requester.ethereumPK)
+		if err != nil {
+			return nil, err
+		}
+
+		return signedTx, ethCl.SendTransaction(ctx, signedTx)
+	}()
+
+	if err != nil {
+		requester.refund(chain.Name, costGwei)
+		return err
+	}
+
+	// the request is only successful once mined without reverting, the cost reserved stays spent until then
+	receiptCtx, cancel := context.WithTimeout(ctx, ethereumReceiptTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(receiptCtx, ethCl, signedTx)
+	if err != nil {
+		return fmt.Errorf("request tx %s not mined: %s", signedTx.Hash().Hex(), err.Error())
+	}
+
+	// only the gas used is paid
+	usedGwei := new(big.Int).Div(new(big.Int).Add(new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(receipt.GasUsed)), big.NewInt(gwei-1)), big.NewInt(gwei)).Uint64()
+	if usedGwei < costGwei {
+		requester.refund(chain.Name, costGwei-usedGwei)
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("request tx %s reverted", signedTx.Hash().Hex())
+	}
+
+	log.Infof("requested new signatures for %s tx %s | request tx: %s | cost: %d gwei", chain.Name, txId, signedTx.Hash().Hex(), usedGwei)
+	return nil
+}
+
+// requestKoinosSignatures calls request_new_signatures on the Koinos bridge contract for the operation opId of the transaction txId
+func (requester *SignaturesRequester) requestKoinosSignatures(ctx context.Context, txId string, opId string) error {
+	args, err := proto.Marshal(&bridge_pb.RequestNewSignaturesArguments{
+		TransactionId: txId,
+		OperationId:   opId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !requester.spend(ChainKoinos, requester.config.KoinosRcLimit, requester.config.KoinosDailyRcLimit) {
+		return fmt.Errorf("daily rc limit of %d reached", requester.config.KoinosDailyRcLimit)
+	}
+
+	op := &protocol.Operation{
+		Op: &protocol.Operation_CallContract{
+			CallContract: &protocol.CallContractOperation{
+				ContractId: requester.koinosContractAddr,
+				EntryPoint: koinosRequestNewSignaturesEntryPoint,
+				Args:       args,
+			},
+		},
+	}
+
+	rpcClient := kjsonrpc.NewKoinosRPCClient(requester.koinosRPC)
+	receipt, err := rpcClient.SubmitTransaction(ctx, []*protocol.Operation{op}, requester.koinosKey, &kjsonrpc.SubmissionParams{RCLimit: requester.config.KoinosRcLimit}, true)
+	if err != nil {
+		requester.refund(ChainKoinos, requester.config.KoinosRcLimit)
+		return err
+	}
+
+	if receipt.RcUsed < requester.config.KoinosRcLimit {
+		requester.refund(ChainKoinos, requester.config.KoinosRcLimit-receipt.RcUsed)
+	}
+
+	if receipt.Reverted {
+		return fmt.Errorf("request tx 0x%x reverted", receipt.Id)
+	}
+
+	log.Infof("requested new signatures for Koinos tx %s-%s | request tx: 0x%x | rc used: %d", txId, opId, receipt.Id, receipt.RcUsed)
+	return nil
+}
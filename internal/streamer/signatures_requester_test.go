@@ -0,0 +1,156 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/mr-tron/base58"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+func newTestSignaturesRequester(t *testing.T, metadataStore *store.MetadataStore) *SignaturesRequester {
+	t.Helper()
+
+	requester, err := NewSignaturesRequester(
+		EvmChains{},
+		store.NewTransactionsStore(store.NewMapBackend()),
+		metadataStore,
+		"",
+		base58.Encode(bytes.Repeat([]byte{4}, 25)),
+		1000,
+		util.SignaturesRequesterConfig{MaxAttempts: 2, RetryDelay: 60000},
+		nil,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return requester
+}
+
+func TestSignaturesRequesterPersistsLimits(t *testing.T) {
+	metadataStore := store.NewMetadataStore(store.NewMapBackend())
+	requester := newTestSignaturesRequester(t, metadataStore)
+
+	now := time.Now()
+	tx := &bridge_pb.Transaction{Status: bridge_pb.TransactionStatus_expired, Expiration: uint64(now.UnixMilli()) - 2000}
+
+	if !requester.mustRequest("koinos-0x01-1", tx, now, map[string]bool{}) {
+		t.Fatal("expected a request for the expired transfer")
+	}
+	if !requester.spend(ChainKoinos, 100, 150) {
+		t.Fatal("expected the spending to be within the daily limit")
+	}
+
+	// a restarted requester keeps the attempts and the spending
+	requester = newTestSignaturesRequester(t, metadataStore)
+
+	if requester.mustRequest("koinos-0x01-1", tx, now.Add(time.Second), map[string]bool{}) {
+		t.Fatal("expected the retry delay to be kept across restarts")
+	}
+	if requester.spend(ChainKoinos, 100, 150) {
+		t.Fatal("expected the daily spending to be kept across restarts")
+	}
+
+	// the attempts of the transfers not expired anymore are forgotten
+	requester.pruneAttempts(map[string]bool{})
+	requester = newTestSignaturesRequester(t, metadataStore)
+
+	if len(requester.attempts) != 0 {
+		t.Fatalf("expected the attempts to be pruned, got %d", len(requester.attempts))
+	}
+}
+
+func TestSignaturesRequesterDoesNotLockTransactions(t *testing.T) {
+	// the RPC blocks on the gas price until released
+	gasPriceRequested := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := jsonRPCRequest{}
+		json.NewDecoder(r.Body).Decode(&request)
+
+		select {
+		case gasPriceRequested <- struct{}{}:
+		default:
+		}
+		<-release
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      request.ID,
+			"error":   map[string]interface{}{"code": -32000, "message": "unavailable"},
+		})
+	}))
+	defer server.Close()
+
+	chain := newTestEvmChain(server.URL, FinalityConfirmations, 0)
+	err := chain.TxStore.Put("0x01-1", &bridge_pb.Transaction{
+		Id:         "0x01",
+		Status:     bridge_pb.TransactionStatus_expired,
+		Expiration: uint64(time.Now().UnixMilli()) - 2000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ethereumPK, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requester, err := NewSignaturesRequester(
+		EvmChains{chain},
+		store.NewTransactionsStore(store.NewMapBackend()),
+		store.NewMetadataStore(store.NewMapBackend()),
+		"",
+		base58.Encode(bytes.Repeat([]byte{4}, 25)),
+		1000,
+		util.SignaturesRequesterConfig{MaxAttempts: 2, RetryDelay: 60000, EthereumMaxGasPrice: 100, EthereumGasLimit: 100000, EthereumDailyLimit: 1000000},
+		ethereumPK,
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		requester.RequestNewSignatures(context.Background())
+		close(done)
+	}()
+	defer func() {
+		close(release)
+		<-done
+	}()
+
+	select {
+	case <-gasPriceRequested:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the request to be submitted")
+	}
+
+	// the transactions can be written while the request is in flight
+	written := make(chan error)
+	go func() {
+		written <- chain.TxStore.Put("0x02-1", &bridge_pb.Transaction{Id: "0x02"})
+	}()
+
+	select {
+	case err = <-written:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the transactions store not to be locked while requesting new signatures")
+	}
+}
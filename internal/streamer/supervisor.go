@@ -0,0 +1,193 @@
+package streamer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+const (
+	StreamerStatusRunning    = "running"
+	StreamerStatusRestarting = "restarting"
+	StreamerStatusStopped    = "stopped"
+	StreamerStatusFailed     = "failed"
+)
+
+const (
+	supervisorMinBackoff = time.Second
+	// a streamer running longer than the max backoff before failing starts a new series of failures
+	supervisorMaxBackoff = time.Minute
+)
+
+// StreamerStatus is the state of a supervised streamer
+type StreamerStatus struct {
+	Name                string `json:"name"`
+	Status              string `json:"status"`
+	StartedAt           int64  `json:"startedAt"`
+	Restarts            uint64 `json:"restarts"`
+	ConsecutiveFailures uint   `json:"consecutiveFailures"`
+	LastError           string `json:"lastError"`
+	LastErrorAt         int64  `json:"lastErrorAt"`
+	NextRestartAt       int64  `json:"nextRestartAt"`
+}
+
+// Supervisor runs the streamers and restarts them with backoff when they exit unexpectedly, after maxFailures
+// consecutive failures of a streamer the supervisor gives up and stops the validator (stop cancels ctx)
+type Supervisor struct {
+	ctx          context.Context
+	stop         context.CancelFunc
+	maxFailures  uint
+	alertWebhook string
+
+	streamers []*StreamerStatus
+	err       error
+	mutex     sync.Mutex
+}
+
+// NewSupervisor creates a new Supervisor, maxFailures 0 restarts the streamers indefinitely
+func NewSupervisor(ctx context.Context, stop context.CancelFunc, maxFailures uint, alertWebhook string) *Supervisor {
+	return &Supervisor{
+		ctx:          ctx,
+		stop:         stop,
+		maxFailures:  maxFailures,
+		alertWebhook: alertWebhook,
+		streamers:    []*StreamerStatus{},
+	}
+}
+
+// Run runs stream in a goroutine until the supervisor context is done, stream must only return before
+// when the streamer cannot go on
+func (supervisor *Supervisor) Run(wg *sync.WaitGroup, name string, stream func(ctx context.Context) error) {
+	status := &StreamerStatus{Name: name}
+
+	supervisor.mutex.Lock()
+	supervisor.streamers = append(supervisor.streamers, status)
+	supervisor.mutex.Unlock()
+
+	wg.Add(1)
+	go supervisor.supervise(wg, status, stream)
+}
+
+func (supervisor *Supervisor) supervise(wg *sync.WaitGroup, status *StreamerStatus, stream func(ctx context.Context) error) {
+	defer wg.Done()
+
+	backoff := supervisorMinBackoff
+
+	for {
+		startedAt := time.Now()
+
+		supervisor.mutex.Lock()
+		status.Status = StreamerStatusRunning
+		status.StartedAt = startedAt.UnixMilli()
+		status.NextRestartAt = 0
+		supervisor.mutex.Unlock()
+
+		err := supervisor.runStream(stream)
+
+		if supervisor.ctx.Err() != nil {
+			supervisor.mutex.Lock()
+			status.Status = StreamerStatusStopped
+			supervisor.mutex.Unlock()
+
+			log.Infof("streamer %s stopped", status.Name)
+			return
+		}
+
+		if err == nil {
+			err = errors.New("streamer exited")
+		}
+
+		supervisor.mutex.Lock()
+		if time.Since(startedAt) >= supervisorMaxBackoff {
+			backoff = supervisorMinBackoff
+			status.ConsecutiveFailures = 0
+		}
+
+		status.ConsecutiveFailures++
+		status.LastError = err.Error()
+		status.LastErrorAt = time.Now().UnixMilli()
+		failures := status.ConsecutiveFailures
+
+		if supervisor.maxFailures > 0 && failures >= supervisor.maxFailures {
+			status.Status = StreamerStatusFailed
+			if supervisor.err == nil {
+				supervisor.err = fmt.Errorf("streamer %s failed %d times in a row: %s", status.Name, failures, err.Error())
+			}
+			statusCopy := *status
+			supervisor.mutex.Unlock()
+
+			log.Errorf("streamer %s failed %d times in a row, stopping the validator: %s", status.Name, failures, err.Error())
+			util.SendAlert(supervisor.alertWebhook, "streamer failed", &statusCopy)
+			supervisor.stop()
+			return
+		}
+
+		status.Status = StreamerStatusRestarting
+		status.NextRestartAt = time.Now().Add(backoff).UnixMilli()
+		supervisor.mutex.Unlock()
+
+		log.Errorf("streamer %s exited (%d consecutive failures), restarting in %s: %s", status.Name, failures, backoff, err.Error())
+
+		select {
+		case <-supervisor.ctx.Done():
+			supervisor.mutex.Lock()
+			status.Status = StreamerStatusStopped
+			status.NextRestartAt = 0
+			supervisor.mutex.Unlock()
+
+			log.Infof("streamer %s stopped", status.Name)
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > supervisorMaxBackoff {
+			backoff = supervisorMaxBackoff
+		}
+
+		supervisor.mutex.Lock()
+		status.Restarts++
+		supervisor.mutex.Unlock()
+	}
+}
+
+// runStream calls stream and turns its panic, e.g. a processor failing to write to the database, into an error
+// so that it is restarted with backoff and counted as a failure like any other exit
+func (supervisor *Supervisor) runStream(stream func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("streamer panicked: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	return stream(supervisor.ctx)
+}
+
+// Status returns a snapshot of the state of the streamers
+func (supervisor *Supervisor) Status() []*StreamerStatus {
+	supervisor.mutex.Lock()
+	defer supervisor.mutex.Unlock()
+
+	statuses := []*StreamerStatus{}
+	for _, status := range supervisor.streamers {
+		statusCopy := *status
+		statuses = append(statuses, &statusCopy)
+	}
+
+	return statuses
+}
+
+// Err returns the failure that made the supervisor stop the validator, nil if none did
+func (supervisor *Supervisor) Err() error {
+	supervisor.mutex.Lock()
+	defer supervisor.mutex.Unlock()
+
+	return supervisor.err
+}
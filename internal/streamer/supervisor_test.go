@@ -0,0 +1,63 @@
+package streamer
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSupervisorRecoversPanic(t *testing.T) {
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+
+	supervisor := NewSupervisor(ctx, stop, 1, "")
+
+	var wg sync.WaitGroup
+	supervisor.Run(&wg, "panicking", func(ctx context.Context) error {
+		panic("cannot write to the database")
+	})
+	wg.Wait()
+
+	if ctx.Err() == nil {
+		t.Fatal("expected the supervisor to stop the validator")
+	}
+
+	err := supervisor.Err()
+	if err == nil || !strings.Contains(err.Error(), "cannot write to the database") {
+		t.Fatalf("expected the panic to be reported as the failure, got %v", err)
+	}
+
+	status := supervisor.Status()[0]
+	if status.Status != StreamerStatusFailed || status.ConsecutiveFailures != 1 {
+		t.Fatalf("expected a failed streamer, got %s after %d failures", status.Status, status.ConsecutiveFailures)
+	}
+}
+
+func TestProcessorLockReleasedOnPanic(t *testing.T) {
+	var mutex sync.Mutex
+
+	func() {
+		defer func() { recover() }()
+
+		storeLock := newProcessorLock(&mutex)
+		defer storeLock.releaseOnPanic()
+
+		storeLock.Lock()
+		panic("processor failed")
+	}()
+
+	// the restarted streamer can lock the store again
+	locked := make(chan struct{})
+	go func() {
+		mutex.Lock()
+		close(locked)
+	}()
+
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("expected the store lock to be released")
+	}
+}
@@ -0,0 +1,120 @@
+package streamer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// ExpirationSweeper moves the transactions whose signatures expired to the expired status
+type ExpirationSweeper struct {
+	evmChains     EvmChains
+	koinosTxStore *store.TransactionsStore
+}
+
+// NewExpirationSweeper creates a new ExpirationSweeper
+func NewExpirationSweeper(evmChains EvmChains, koinosTxStore *store.TransactionsStore) *ExpirationSweeper {
+	return &ExpirationSweeper{
+		evmChains:     evmChains,
+		koinosTxStore: koinosTxStore,
+	}
+}
+
+// Run sweeps the transactions every interval ms until ctx is done
+func (sweeper *ExpirationSweeper) Run(wg *sync.WaitGroup, ctx context.Context, interval uint) {
+	defer wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("stop expiration sweeper")
+			return
+
+		case <-time.After(time.Millisecond * time.Duration(interval)):
+			sweeper.Sweep()
+		}
+	}
+}
+
+// Sweep moves the transactions gathering signatures or signed whose expiration passed to the expired status,
+// it returns the number of transactions expired
+func (sweeper *ExpirationSweeper) Sweep() int {
+	now := uint64(time.Now().UnixMilli())
+	count := 0
+
+	for _, chain := range sweeper.evmChains {
+		expired, err := expireTransactions(chain.TxStore, now)
+		if err != nil {
+			log.Errorf("cannot sweep %s transactions: %s", chain.Name, err.Error())
+		}
+		count += expired
+	}
+
+	expired, err := expireTransactions(sweeper.koinosTxStore, now)
+	if err != nil {
+		log.Errorf("cannot sweep Koinos transactions: %s", err.Error())
+	}
+	count += expired
+
+	if count > 0 {
+		log.Infof("%d transactions expired", count)
+	}
+
+	return count
+}
+
+func expireTransactions(txStore *store.TransactionsStore, now uint64) (int, error) {
+	txStore.Lock()
+	defer txStore.Unlock()
+
+	keys := []string{}
+	txs := []*bridge_pb.Transaction{}
+
+	err := txStore.Iterate(func(key string, tx *bridge_pb.Transaction) error {
+		if (tx.Status == bridge_pb.TransactionStatus_gathering_signatures || tx.Status == bridge_pb.TransactionStatus_signed) &&
+			tx.Expiration != 0 && tx.Expiration < now {
+			keys = append(keys, key)
+			txs = append(txs, tx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for index, tx := range txs {
+		tx.Status = bridge_pb.TransactionStatus_expired
+		tx.ExpiredAt = now
+
+		err = txStore.Update(context.Background(), keys[index], tx, &bridge_pb.TransactionTransition{Cause: bridge_pb.TransitionCause_sweeper})
+		if err != nil {
+			return index, err
+		}
+	}
+
+	return len(txs), nil
+}
+
+// SetRequestNewSignaturesTime sets when new signatures can be requested for the transactions not completed: the streamers
+// sign again on a request_new_signatures event once its blocktime reaches the expiration plus signaturesExpiration
+func SetRequestNewSignaturesTime(signaturesExpiration uint, txs ...*bridge_pb.Transaction) {
+	now := uint64(time.Now().UnixMilli())
+
+	for _, tx := range txs {
+		if tx.Status == bridge_pb.TransactionStatus_completed || tx.Status == bridge_pb.TransactionStatus_pending_confirmations || tx.Expiration == 0 {
+			continue
+		}
+
+		tx.RequestNewSignaturesAt = tx.Expiration + uint64(signaturesExpiration)
+		tx.RequestNewSignaturesIn = 0
+		if tx.RequestNewSignaturesAt > now {
+			tx.RequestNewSignaturesIn = tx.RequestNewSignaturesAt - now
+		}
+	}
+}
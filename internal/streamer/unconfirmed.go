@@ -0,0 +1,112 @@
+package streamer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/koinos/koinos-log-golang"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+// trackUnconfirmedLogs records the TokensLockedEvent emitted in the blocks following lastFinalBlock, up to head, in the
+// unconfirmed transactions of the chain with the pending_confirmations status and their confirmations. They are not signed,
+// the streamer processes them once final. The unconfirmed transactions not emitted anymore (reorg) are dropped,
+// nextBlock is the next block the streamer will parse.
+func trackUnconfirmedLogs(ctx context.Context, ethCl *EvmClient, processor *ethereumEventsProcessor, lastFinalBlock uint64, nextBlock uint64) error {
+	chain := processor.chain
+
+	head, err := ethCl.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+
+	found := make(map[string]bool)
+
+	for fromBlock := lastFinalBlock + 1; fromBlock <= head; {
+		logs, toBlock, err := filterLogs(ctx, ethCl, chain, fromBlock, head)
+		if err != nil {
+			return err
+		}
+
+		for _, vLog := range logs {
+			if vLog.Removed || vLog.Topics[0] != tokensLockedEventTopic {
+				continue
+			}
+
+			ethTx, err := processor.unconfirmedTransaction(vLog, head-vLog.BlockNumber+1)
+			if err != nil {
+				return err
+			}
+
+			txKey := EthereumTransactionKey(ethTx.Id, uint64(vLog.Index))
+			err = chain.UnconfirmedTxStore.Put(txKey, ethTx)
+			if err != nil {
+				return err
+			}
+
+			found[txKey] = true
+		}
+
+		fromBlock = toBlock + 1
+	}
+
+	// not emitted anymore in the unconfirmed blocks, or parsed by the streamer once final without being processed
+	dropped := []string{}
+	err = chain.UnconfirmedTxStore.Iterate(func(key string, ethTx *bridge_pb.Transaction) error {
+		if !found[key] && (ethTx.BlockNumber > lastFinalBlock || ethTx.BlockNumber < nextBlock) {
+			dropped = append(dropped, key)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, txKey := range dropped {
+		log.Infof("%s tx %s dropped by a reorg before being final", chain.Name, txKey)
+
+		err = chain.UnconfirmedTxStore.Delete(txKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unconfirmedTransaction returns the transaction of a TokensLockedEvent not final yet, it is neither hashed nor signed
+func (processor *ethereumEventsProcessor) unconfirmedTransaction(vLog types.Log, confirmations uint64) (*bridge_pb.Transaction, error) {
+	event := tokensLockedEvent{}
+
+	err := processor.tokensLockedEventAbi.UnpackIntoInterface(&event, "TokensLockedEvent", vLog.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	ethToken := event.Token.Hex()
+	blocktime := event.Blocktime.Uint64()
+
+	return &bridge_pb.Transaction{
+		Type:          bridge_pb.TransactionType_ethereum,
+		Id:            vLog.TxHash.Hex(),
+		OpId:          fmt.Sprint(vLog.Index),
+		BlockNumber:   vLog.BlockNumber,
+		BlockTime:     blocktime,
+		Expiration:    blocktime + uint64(processor.signaturesExpiration),
+		From:          event.From.Hex(),
+		EthToken:      ethToken,
+		KoinosToken:   processor.chain.TokenAddresses[ethToken].KoinosAddress,
+		Amount:        event.Amount.String(),
+		Payment:       event.Payment.String(),
+		Relayer:       event.Relayer,
+		Recipient:     event.Recipient,
+		Metadata:      event.Metadata,
+		Status:        bridge_pb.TransactionStatus_pending_confirmations,
+		ToChain:       fmt.Sprint(event.Chain),
+		FromChain:     processor.chain.ChainIdStr(),
+		Confirmations: confirmations,
+	}, nil
+}
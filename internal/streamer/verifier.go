@@ -0,0 +1,314 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	log "github.com/koinos/koinos-log-golang"
+	"github.com/koinos/koinos-proto-golang/koinos/rpc/block_store"
+	kjsonrpc "github.com/koinos/koinos-util-golang/rpc"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/rpc"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+const (
+	// verifierTimeout bounds a verification in the background
+	verifierTimeout = time.Minute
+	// verifierSyncTimeout bounds a verification a peer waits for, below the timeout of the peers requests
+	verifierSyncTimeout = 10 * time.Second
+)
+
+// ErrTransactionRejected is returned when a transaction verified cannot be signed, it reverted or did not emit a tokens locked event
+var ErrTransactionRejected = errors.New("transaction rejected")
+
+// Verifier verifies on demand the transactions submitted by the peers that the streamers did not reach yet.
+// The receipt of the transaction is fetched from the RPC and, once final, its tokens locked events
+// are processed (signed, stored and broadcasted) like the streamers would. The events are locked in the
+// processed events store so that the streamers and the verifier never process the same event concurrently.
+type Verifier struct {
+	ctx           context.Context
+	evmChains     EvmChains
+	ethProcessors map[string]*ethereumEventsProcessor
+
+	koinosProcessor *koinosEventsProcessor
+	koinosRPC       string
+
+	// transactions being verified in the background
+	running map[string]bool
+	mutex   sync.Mutex
+	wg      sync.WaitGroup
+}
+
+// NewVerifier creates a new Verifier, the verifications in the background stop when ctx is done
+func NewVerifier(
+	ctx context.Context,
+	evmChains EvmChains,
+	ethereumPK *ecdsa.PrivateKey,
+	ethereumAddress string,
+	koinosRPC string,
+	koinosPK []byte,
+	koinosAddress string,
+	koinosContractStr string,
+	koinosTxStore *store.TransactionsStore,
+	processedEventsStore *store.ProcessedEventsStore,
+	pendingTxStore *store.PendingTransactionsStore,
+	signingAuditLog *store.SigningAuditLogStore,
+	signaturesExpiration uint,
+	validators map[string]util.ValidatorConfig,
+	alertWebhook string,
+) (*Verifier, error) {
+	ethProcessors, koinosProcessor, err := newEventsProcessors(
+		evmChains,
+		ethereumPK,
+		ethereumAddress,
+		koinosPK,
+		koinosAddress,
+		koinosContractStr,
+		koinosTxStore,
+		processedEventsStore,
+		pendingTxStore,
+		signingAuditLog,
+		signaturesExpiration,
+		validators,
+		alertWebhook,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Verifier{
+		ctx:             ctx,
+		running:         make(map[string]bool),
+		evmChains:       evmChains,
+		ethProcessors:   ethProcessors,
+		koinosProcessor: koinosProcessor,
+		koinosRPC:       koinosRPC,
+	}, nil
+}
+
+// VerifyEthereumTransaction processes the TokensLockedEvent logs emitted by the bridge contract in the transaction txId
+// of the EVM chain chainName, the transaction must have the confirmations required by the chain
+func (verifier *Verifier) VerifyEthereumTransaction(ctx context.Context, chainName string, txId string) error {
+	chain := verifier.evmChains.Get(chainName)
+	if chain == nil {
+		return fmt.Errorf("invalid chain %s", chainName)
+	}
+
+	ethCl, err := DialEvmChain(ctx, chain)
+	if err != nil {
+		return err
+	}
+	defer ethCl.Close()
+
+	receipt, err := ethCl.TransactionReceipt(ctx, common.HexToHash(txId))
+	if err != nil {
+		return err
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("%w, %s tx %s reverted", ErrTransactionRejected, chain.Name, txId)
+	}
+
+	lastFinalBlock, err := ethCl.LastFinalBlock(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockNumber := receipt.BlockNumber.Uint64()
+	if blockNumber > lastFinalBlock {
+		return fmt.Errorf("%s tx %s (block %d) is not final yet (%s)", chain.Name, txId, blockNumber, chain.FinalityStr())
+	}
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if vLog.Address != chain.ContractAddr || len(vLog.Topics) == 0 || vLog.Topics[0] != tokensLockedEventTopic {
+			continue
+		}
+
+		log.Infof("verifying %s tx %s on demand | block: %d | log index: %d", chain.Name, txId, vLog.BlockNumber, vLog.Index)
+		verifier.ethProcessors[chain.Name].processLog(*vLog)
+		found = true
+	}
+
+	if !found {
+		return fmt.Errorf("%w, %s tx %s did not emit a TokensLockedEvent", ErrTransactionRejected, chain.Name, txId)
+	}
+
+	return nil
+}
+
+// VerifyKoinosTransaction processes the tokens_locked_event events emitted by the bridge contract in the transaction txId,
+// the block including the transaction must be irreversible
+func (verifier *Verifier) VerifyKoinosTransaction(ctx context.Context, txId string) error {
+	rpcClient := rpc.NewJsonRPC(kjsonrpc.NewKoinosRPCClient(verifier.koinosRPC))
+
+	transactions, err := rpcClient.GetTransactionsById(ctx, [][]byte{common.FromHex(txId)})
+	if err != nil {
+		return err
+	}
+
+	if len(transactions.Transactions) == 0 || transactions.Transactions[0] == nil {
+		return fmt.Errorf("Koinos tx %s does not exist", txId)
+	}
+
+	headInfo, err := rpcClient.GetHeadInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the transaction may be included in blocks of different forks, only the one of the main chain counts
+	for _, blockId := range transactions.Transactions[0].ContainingBlocks {
+		blocks, err := rpcClient.GetBlocksById(ctx, [][]byte{blockId})
+		if err != nil {
+			return err
+		}
+
+		if len(blocks.BlockItems) == 0 || blocks.BlockItems[0].BlockHeight == 0 {
+			continue
+		}
+
+		height := blocks.BlockItems[0].BlockHeight
+		if height > headInfo.LastIrreversibleBlock {
+			return fmt.Errorf("Koinos tx %s (block %d) is not irreversible yet", txId, height)
+		}
+
+		canonicalBlocks, err := rpcClient.GetBlocksByHeight(ctx, headInfo.HeadTopology.Id, height, 1)
+		if err != nil {
+			return err
+		}
+
+		if len(canonicalBlocks.BlockItems) == 0 || !bytes.Equal(canonicalBlocks.BlockItems[0].BlockId, blockId) {
+			continue
+		}
+
+		return verifier.processKoinosTransaction(canonicalBlocks.BlockItems[0], txId)
+	}
+
+	return fmt.Errorf("Koinos tx %s is not included in the main chain", txId)
+}
+
+func (verifier *Verifier) processKoinosTransaction(block *block_store.BlockItem, txId string) error {
+	for _, receipt := range block.Receipt.TransactionReceipts {
+		if !bytes.Equal(receipt.Id, common.FromHex(txId)) {
+			continue
+		}
+
+		if receipt.Reverted {
+			return fmt.Errorf("%w, Koinos tx %s reverted", ErrTransactionRejected, txId)
+		}
+
+		found := false
+		for _, event := range receipt.Events {
+			if event.Name != "bridge.tokens_locked_event" {
+				continue
+			}
+
+			log.Infof("verifying Koinos tx %s on demand | block: %d | sequence: %d", txId, block.BlockHeight, event.Sequence)
+			if name, _ := verifier.koinosProcessor.processEvent(block, receipt, event); name != "" {
+				found = true
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("%w, Koinos tx %s did not emit a bridge.tokens_locked_event", ErrTransactionRejected, txId)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("Koinos tx %s receipt not found in block %d", txId, block.BlockHeight)
+}
+
+// VerifyEthereumTransactionNow runs VerifyEthereumTransaction within verifierSyncTimeout, for a peer waiting for our signature
+func (verifier *Verifier) VerifyEthereumTransactionNow(ctx context.Context, chainName string, txId string) error {
+	return verifier.verifyNow(ctx, func(ctx context.Context) error {
+		return verifier.VerifyEthereumTransaction(ctx, chainName, txId)
+	})
+}
+
+// VerifyKoinosTransactionNow runs VerifyKoinosTransaction within verifierSyncTimeout, for a peer waiting for our signature
+func (verifier *Verifier) VerifyKoinosTransactionNow(ctx context.Context, txId string) error {
+	return verifier.verifyNow(ctx, func(ctx context.Context) error {
+		return verifier.VerifyKoinosTransaction(ctx, txId)
+	})
+}
+
+func (verifier *Verifier) verifyNow(ctx context.Context, verify func(ctx context.Context) error) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, verifierSyncTimeout)
+	defer cancel()
+
+	// the processors panic on storage errors, the streamer will process the events
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("verification panicked: %v", r)
+		}
+	}()
+
+	return verify(ctx)
+}
+
+// VerifyEthereumTransactionAsync runs VerifyEthereumTransaction in the background, the span of ctx is the parent of
+// the verification but its cancellation is ignored. Nothing is done if the transaction is already being verified.
+func (verifier *Verifier) VerifyEthereumTransactionAsync(ctx context.Context, chainName string, txId string) {
+	verifier.verifyAsync(ctx, chainName+" tx "+txId, func(ctx context.Context) error {
+		return verifier.VerifyEthereumTransaction(ctx, chainName, txId)
+	})
+}
+
+// VerifyKoinosTransactionAsync runs VerifyKoinosTransaction in the background, the span of ctx is the parent of
+// the verification but its cancellation is ignored. Nothing is done if the transaction is already being verified.
+func (verifier *Verifier) VerifyKoinosTransactionAsync(ctx context.Context, txId string) {
+	verifier.verifyAsync(ctx, "Koinos tx "+txId, func(ctx context.Context) error {
+		return verifier.VerifyKoinosTransaction(ctx, txId)
+	})
+}
+
+func (verifier *Verifier) verifyAsync(parentCtx context.Context, name string, verify func(ctx context.Context) error) {
+	verifier.mutex.Lock()
+	if verifier.running[name] || verifier.ctx.Err() != nil {
+		verifier.mutex.Unlock()
+		return
+	}
+	verifier.running[name] = true
+	verifier.wg.Add(1)
+	verifier.mutex.Unlock()
+
+	go func() {
+		defer verifier.wg.Done()
+		defer func() {
+			verifier.mutex.Lock()
+			delete(verifier.running, name)
+			verifier.mutex.Unlock()
+		}()
+
+		// the processors panic on storage errors, the validator keeps running and the streamer will process the events
+		defer func() {
+			if r := recover(); r != nil {
+				log.Errorf("verification of %s on demand panicked: %v", name, r)
+			}
+		}()
+
+		ctx, cancel := context.WithTimeout(verifier.ctx, verifierTimeout)
+		defer cancel()
+
+		err := verify(trace.ContextWithSpan(ctx, trace.SpanFromContext(parentCtx)))
+		if err != nil {
+			log.Infof("cannot verify %s on demand: %s", name, err.Error())
+		}
+	}()
+}
+
+// Wait waits for the verifications running in the background, no verification is started once the context is done
+func (verifier *Verifier) Wait() {
+	verifier.wg.Wait()
+}
@@ -0,0 +1,78 @@
+package streamer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/mr-tron/base58"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/util"
+)
+
+func TestVerifyEthereumTransactionNow(t *testing.T) {
+	vLog := testTokensLockedLog(t, 15)
+	rpc := &fakeEvmRPC{head: 30, final: 10, logs: []types.Log{vLog}}
+	server := httptest.NewServer(rpc)
+	defer server.Close()
+
+	chain := newTestEvmChain(server.URL, FinalityFinalized, 0)
+	signingAuditLog, err := store.NewSigningAuditLogStore(store.NewMapBackend())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifier, err := NewVerifier(
+		context.Background(),
+		EvmChains{chain},
+		nil,
+		"",
+		"",
+		testKoinosPK,
+		"validator",
+		base58.Encode(bytes.Repeat([]byte{4}, 25)),
+		store.NewTransactionsStore(store.NewMapBackend()),
+		store.NewProcessedEventsStore(store.NewMapBackend()),
+		store.NewPendingTransactionsStore(store.NewMapBackend()),
+		signingAuditLog,
+		60000,
+		map[string]util.ValidatorConfig{},
+		"",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txKey := EthereumTransactionKey(vLog.TxHash.Hex(), uint64(vLog.Index))
+
+	// a transaction not final yet may be verified later
+	err = verifier.VerifyEthereumTransactionNow(context.Background(), chain.Name, vLog.TxHash.Hex())
+	if err == nil || errors.Is(err, ErrTransactionRejected) {
+		t.Fatalf("expected the transaction not to be final yet, got %v", err)
+	}
+	if ethTx, _ := chain.TxStore.Get(txKey); ethTx != nil {
+		t.Fatal("expected the transaction not to be signed before its block is final")
+	}
+
+	// an unknown transaction cannot be verified
+	err = verifier.VerifyEthereumTransactionNow(context.Background(), chain.Name, "0x0203")
+	if err == nil {
+		t.Fatal("expected the verification of an unknown transaction to fail")
+	}
+
+	rpc.setFinal(20)
+
+	err = verifier.VerifyEthereumTransactionNow(context.Background(), chain.Name, vLog.TxHash.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ethTx, err := chain.TxStore.Get(txKey)
+	if err != nil || ethTx == nil || len(ethTx.Signatures) != 1 || ethTx.Validators[0] != "validator" {
+		t.Fatalf("expected the transaction to be signed once verified, got %v, %v", ethTx, err)
+	}
+}
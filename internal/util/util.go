@@ -2,9 +2,11 @@ package util
 
 import (
 	"bytes"
+	"context"
 	"crypto/ecdsa"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -17,11 +19,15 @@ import (
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcutil"
+	btcbase58 "github.com/btcsuite/btcutil/base58"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/tracing"
 	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
 	log "github.com/koinos/koinos-log-golang"
 	"github.com/mr-tron/base58"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"gopkg.in/yaml.v2"
@@ -36,6 +42,78 @@ type ValidatorConfig struct {
 type TokenConfig struct {
 	EthereumAddress string `yaml:"ethereum-address"`
 	KoinosAddress   string `yaml:"koinos-address"`
+	// chain where the token is native ("koinos" or the EVM chain, e.g. "ethereum"), the bridge contract
+	// on the other chain mints and burns a wrapped token instead of holding funds
+	NativeChain string `yaml:"native-chain"`
+}
+
+type EvmChainConfig struct {
+	// unique name of the chain, the legacy single chain is named "ethereum"
+	Name string `yaml:"name"`
+	// chain id used by the bridge contracts events, a single chain can omit it to receive the transfers to any other chain id
+	ChainId         uint64                 `yaml:"chain-id"`
+	Rpc             string                 `yaml:"rpc"`
+	Contract        string                 `yaml:"contract"`
+	BlockStart      uint64                 `yaml:"block-start"`
+	MaxBlocksStream uint64                 `yaml:"max-blocks-stream"`
+	Confirmations   uint64                 `yaml:"confirmations"`
+	PollingTime     uint                   `yaml:"polling-time"`
+	CatchUpDistance uint64                 `yaml:"catch-up-distance"`
+	Tokens          map[string]TokenConfig `yaml:"tokens"`
+	// "confirmations" (default), "safe" or "finalized"
+	Finality string `yaml:"finality"`
+}
+
+// SignaturesRequesterConfig configures the submission of request_new_signatures for the expired transfers
+type SignaturesRequesterConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// interval in ms between two checks of the expired transfers
+	Interval uint `yaml:"interval"`
+	// requests submitted per transfer and expiration, and minimum delay in ms between two of them
+	MaxAttempts uint `yaml:"max-attempts"`
+	RetryDelay  uint `yaml:"retry-delay"`
+
+	// keys paying the requests, they must differ from the signing keys, a chain without key is not requested
+	EthereumPK string `yaml:"ethereum-pk"`
+	KoinosPK   string `yaml:"koinos-pk"`
+
+	// spending limits, the Ethereum ones are per EVM chain and in gwei
+	EthereumGasLimit    uint64 `yaml:"ethereum-gas-limit"`
+	EthereumMaxGasPrice uint64 `yaml:"ethereum-max-gas-price"`
+	EthereumDailyLimit  uint64 `yaml:"ethereum-daily-limit"`
+	KoinosRcLimit       uint64 `yaml:"koinos-rc-limit"`
+	KoinosDailyRcLimit  uint64 `yaml:"koinos-daily-rc-limit"`
+}
+
+type StorageConfig struct {
+	// "badger" (default), "sqlite" or "postgres"
+	Driver string `yaml:"driver"`
+	// SQLite file path or PostgreSQL connection string
+	Dsn string `yaml:"dsn"`
+	// interval in ms between two garbage collections of the badger value logs
+	ValueLogGCInterval uint `yaml:"value-log-gc-interval"`
+}
+
+// RetentionConfig configures the archival of the completed transactions
+type RetentionConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// days the completed transactions are kept in the transactions stores, from their block time
+	CompletedDays uint `yaml:"completed-days"`
+	// interval in ms between two archivals
+	Interval uint `yaml:"interval"`
+	// directory of the archive files, defaults to archive in the app directory
+	ArchiveDir string `yaml:"archive-dir"`
+}
+
+// TracingConfig configures the export of the OpenTelemetry spans of the signing pipeline
+type TracingConfig struct {
+	// none (default), otlp or stdout
+	Exporter string `yaml:"exporter"`
+	// host:port of the OTLP collector, the OpenTelemetry environment variables are used when empty
+	Endpoint string `yaml:"endpoint"`
+	// sends the spans to the OTLP collector over HTTP instead of HTTPS
+	Insecure    bool   `yaml:"insecure"`
+	ServiceName string `yaml:"service-name"`
 }
 
 type BridgeConfig struct {
@@ -44,14 +122,32 @@ type BridgeConfig struct {
 	LogLevel             string `yaml:"log-level"`
 	SignaturesExpiration uint   `yaml:"signatures-expiration"`
 	ApiUrl               string `yaml:"api-url"`
+	AlertWebhook         string `yaml:"alert-webhook"`
+	AdminApiUrl          string `yaml:"admin-api-url"`
+
+	ReconciliationInterval  uint `yaml:"reconciliation-interval"`
+	StreamersMaxFailures    uint `yaml:"streamers-max-failures"`
+	CatchUpWorkers          uint `yaml:"catch-up-workers"`
+	ExpirationSweepInterval uint `yaml:"expiration-sweep-interval"`
+
+	Storage StorageConfig `yaml:"storage"`
+
+	AutoRequestNewSignatures SignaturesRequesterConfig `yaml:"auto-request-new-signatures"`
+
+	Retention RetentionConfig `yaml:"retention"`
+
+	Tracing TracingConfig `yaml:"tracing"`
 
 	EthereumRpc             string `yaml:"ethereum-rpc"`
+	EthereumChainId         uint64 `yaml:"ethereum-chain-id"`
 	EthereumContract        string `yaml:"ethereum-contract"`
 	EthereumBlockStart      uint64 `yaml:"ethereum-block-start"`
 	EthereumPK              string `yaml:"ethereum-pk"`
 	EthereumMaxBlocksStream uint64 `yaml:"ethereum-max-blocks-stream"`
 	EthereumConfirmations   uint64 `yaml:"ethereum-confirmations"`
+	EthereumFinality        string `yaml:"ethereum-finality"`
 	EthereumPollingTime     uint   `yaml:"ethereum-polling-time"`
+	EthereumCatchUpDistance uint64 `yaml:"ethereum-catch-up-distance"`
 
 	KoinosRpc             string `yaml:"koinos-rpc"`
 	KoinosContract        string `yaml:"koinos-contract"`
@@ -59,6 +155,12 @@ type BridgeConfig struct {
 	KoinosPK              string `yaml:"koinos-pk"`
 	KoinosMaxBlocksStream uint64 `yaml:"koinos-max-blocks-stream"`
 	KoinosPollingTime     uint   `yaml:"koinos-polling-time"`
+	KoinosCatchUpDistance uint64 `yaml:"koinos-catch-up-distance"`
+	// base64 chain id returned by chain.get_chain_id, checked against the RPC when set
+	KoinosChainId string `yaml:"koinos-chain-id"`
+
+	// when empty, a single chain named "ethereum" is configured with the ethereum-* options and tokens
+	EvmChains []EvmChainConfig `yaml:"evm-chains"`
 
 	Validators map[string]ValidatorConfig `yaml:"validators"`
 	Tokens     map[string]TokenConfig     `yaml:"tokens"`
@@ -95,8 +197,18 @@ func InitYamlConfig(baseDir string) *YamlConfig {
 	return &yamlConfig
 }
 
-func SignKoinosHash(key []byte, hash []byte) []byte {
-	privateKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), key)
+const (
+	KeyTypeKoinos   = "koinos"
+	KeyTypeEthereum = "ethereum"
+)
+
+// SignKoinosHash signs the sha256 hash of hashedData and records the signature in the signing audit log,
+// source describes the event that triggered the signature (chain, block, transaction, index and event)
+func SignKoinosHash(ctx context.Context, auditLog *store.SigningAuditLogStore, source *bridge_pb.SigningAuditEntry, key []byte, hashedData []byte, hash []byte) []byte {
+	_, span := tracing.Start(ctx, "SignKoinosHash")
+	defer span.End()
+
+	privateKey, publicKey := btcec.PrivKeyFromBytes(btcec.S256(), key)
 
 	// Sign the hash
 	signatureBytes, err := btcec.SignCompact(btcec.S256(), privateKey, hash, true)
@@ -105,10 +217,23 @@ func SignKoinosHash(key []byte, hash []byte) []byte {
 		panic(err)
 	}
 
+	addressBytes, err := KoinosPublicKeyToAddress(publicKey)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+
+	auditSignature(auditLog, source, KeyTypeKoinos, base58.Encode(addressBytes), hashedData, hash, signatureBytes)
+
 	return signatureBytes
 }
 
-func SignEthereumHash(key *ecdsa.PrivateKey, hash []byte) []byte {
+// SignEthereumHash signs the prefixed keccak256 hash of hashedData and records the signature in the signing audit log,
+// source describes the event that triggered the signature (chain, block, transaction, index and event)
+func SignEthereumHash(ctx context.Context, auditLog *store.SigningAuditLogStore, source *bridge_pb.SigningAuditEntry, key *ecdsa.PrivateKey, hashedData []byte, hash []byte) []byte {
+	_, span := tracing.Start(ctx, "SignEthereumHash")
+	defer span.End()
+
 	signatureBytes, err := crypto.Sign(hash, key)
 
 	if err != nil {
@@ -117,9 +242,65 @@ func SignEthereumHash(key *ecdsa.PrivateKey, hash []byte) []byte {
 	}
 	signatureBytes[crypto.RecoveryIDOffset] += 27
 
+	auditSignature(auditLog, source, KeyTypeEthereum, crypto.PubkeyToAddress(key.PublicKey).Hex(), hashedData, hash, signatureBytes)
+
 	return signatureBytes
 }
 
+// auditSignature appends a signature to the signing audit log, a signature that cannot be audited is fatal
+func auditSignature(auditLog *store.SigningAuditLogStore, source *bridge_pb.SigningAuditEntry, keyType string, signer string, hashedData []byte, hash []byte, signature []byte) {
+	if auditLog == nil {
+		return
+	}
+
+	entry := proto.Clone(source).(*bridge_pb.SigningAuditEntry)
+	entry.KeyType = keyType
+	entry.Signer = signer
+	entry.HashedData = hashedData
+	entry.Hash = hash
+	entry.Signature = signature
+
+	_, err := auditLog.Append(entry)
+	if err != nil {
+		log.Error(err.Error())
+		panic(err)
+	}
+}
+
+// VerifySigningAuditEntrySignature checks that the hash of an audit log entry is the hash of its hashed data
+// and that its signature was made by its signer
+func VerifySigningAuditEntrySignature(entry *bridge_pb.SigningAuditEntry) error {
+	var expectedHash []byte
+	var recoveredAddr string
+	var err error
+
+	switch entry.KeyType {
+	case KeyTypeKoinos:
+		hash := sha256.Sum256(entry.HashedData)
+		expectedHash = hash[:]
+		recoveredAddr, err = RecoverKoinosAddressFromSignature(base64.URLEncoding.EncodeToString(entry.Signature), entry.Hash)
+	case KeyTypeEthereum:
+		expectedHash = EthereumPrefixedHash(crypto.Keccak256Hash(entry.HashedData)).Bytes()
+		recoveredAddr, err = RecoverEthereumAddressFromSignature("0x"+common.Bytes2Hex(entry.Signature), entry.Hash)
+	default:
+		return fmt.Errorf("entry %d has an unknown key type %s", entry.Sequence, entry.KeyType)
+	}
+
+	if !bytes.Equal(entry.Hash, expectedHash) {
+		return fmt.Errorf("hash of entry %d is not the hash of its hashed data", entry.Sequence)
+	}
+
+	if err != nil {
+		return fmt.Errorf("cannot recover the signer of entry %d: %s", entry.Sequence, err)
+	}
+
+	if recoveredAddr != entry.Signer {
+		return fmt.Errorf("signature of entry %d was made by %s instead of %s", entry.Sequence, recoveredAddr, entry.Signer)
+	}
+
+	return nil
+}
+
 func GetStringOption(a string, b string) string {
 	if a != "" {
 		return a
@@ -157,6 +338,35 @@ func KoinosPublicKeyToAddress(pubkey *btcec.PublicKey) ([]byte, error) {
 	return base58.Decode(mainNetAddr.EncodeAddress())
 }
 
+// ValidateKoinosAddress returns an error if address is not a base58 Koinos address with a valid checksum
+func ValidateKoinosAddress(address string) error {
+	payload, version, err := btcbase58.CheckDecode(address)
+	if err != nil {
+		return fmt.Errorf("invalid Koinos address \"%s\": %s", address, err)
+	}
+
+	if version != chaincfg.MainNetParams.PubKeyHashAddrID || len(payload) != 20 {
+		return fmt.Errorf("invalid Koinos address \"%s\"", address)
+	}
+
+	return nil
+}
+
+// ValidateEthereumAddress returns an error if address is not an EVM address in its EIP-55 checksummed form,
+// the addresses are compared with the checksummed addresses of the events and of the signers
+func ValidateEthereumAddress(address string) error {
+	if !common.IsHexAddress(address) {
+		return fmt.Errorf("invalid EVM address \"%s\"", address)
+	}
+
+	checksummed := common.HexToAddress(address).Hex()
+	if address != checksummed {
+		return fmt.Errorf("invalid checksum of EVM address \"%s\", expected %s", address, checksummed)
+	}
+
+	return nil
+}
+
 func RecoverEthereumAddressFromSignature(signature string, prefixedHash []byte) (string, error) {
 	signatureBytes := common.Hex2Bytes(signature[2:])
 
@@ -193,11 +403,17 @@ func RecoverKoinosAddressFromSignature(signature string, hash []byte) (string, e
 	return base58.Encode(validatorAddressBytes), nil
 }
 
-func BroadcastTransaction(tx *bridge_pb.Transaction, koinosPK []byte, koinosAddress string, validators map[string]ValidatorConfig) (map[string]string, error) {
+// BroadcastTransaction submits the signature of tx to the peers, source describes the event
+// that triggered the broadcast in the signing audit log. The trace context of ctx is sent to the peers.
+func BroadcastTransaction(ctx context.Context, tx *bridge_pb.Transaction, koinosPK []byte, koinosAddress string, validators map[string]ValidatorConfig, auditLog *store.SigningAuditLogStore, source *bridge_pb.SigningAuditEntry) (map[string]string, error) {
+	ctx, span := tracing.Start(ctx, "BroadcastTransaction", attribute.String("transaction.id", tx.Id))
+	defer span.End()
+
 	signatures := make(map[string]string)
 
 	txBytes, err := proto.Marshal(tx)
 	if err != nil {
+		tracing.SetError(span, err)
 		return nil, err
 	}
 
@@ -208,7 +424,7 @@ func BroadcastTransaction(tx *bridge_pb.Transaction, koinosPK []byte, koinosAddr
 	bytesToHash := append(txBytes, expirationBytes...)
 
 	hash := sha256.Sum256(bytesToHash)
-	sigBytes := SignKoinosHash(koinosPK, hash[:])
+	sigBytes := SignKoinosHash(ctx, auditLog, source, koinosPK, bytesToHash, hash[:])
 	sigB64 := base64.URLEncoding.EncodeToString(sigBytes)
 
 	submittedSignature := &bridge_pb.SubmittedSignature{
@@ -219,6 +435,7 @@ func BroadcastTransaction(tx *bridge_pb.Transaction, koinosPK []byte, koinosAddr
 
 	submittedSignatureBytes, err := protojson.Marshal(submittedSignature)
 	if err != nil {
+		tracing.SetError(span, err)
 		return nil, err
 	}
 
@@ -237,44 +454,248 @@ func BroadcastTransaction(tx *bridge_pb.Transaction, koinosPK []byte, koinosAddr
 			continue
 		}
 
-		bodyReader := bytes.NewReader(submittedSignatureBytes)
-		req, err := http.NewRequest(http.MethodPost, validator.ApiUrl+"/SubmitSignature", bodyReader)
+		body, err := submitSignature(ctx, validator, tx.Id, submittedSignatureBytes)
+		if err != nil {
+			continue
+		}
+
+		if body != "" {
+			log.Debugf("client: received signature %s\n", body)
+			signatures[validator.KoinosAddress] = body
+		}
+
+		processedApiUrls[validator.ApiUrl] = true
+	}
+
+	return signatures, nil
+}
 
+// submitSignature posts the submitted signature of tx txId to the validator and returns the signature it sent back,
+// an error if the validator could not be reached
+func submitSignature(ctx context.Context, validator ValidatorConfig, txId string, submittedSignatureBytes []byte) (string, error) {
+	ctx, span := tracing.StartClient(ctx, "SubmitSignature", attribute.String("validator", validator.KoinosAddress))
+	defer span.End()
+
+	bodyReader := bytes.NewReader(submittedSignatureBytes)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, validator.ApiUrl+"/SubmitSignature", bodyReader)
+
+	if err != nil {
+		log.Errorf("client: could not create request: %s\n", err)
+		tracing.SetError(span, err)
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	tracing.Inject(ctx, req.Header)
+
+	client := http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		log.Errorf("client: error making http request to %s: %s\n", validator.KoinosAddress, err)
+		tracing.SetError(span, err)
+		return "", err
+	}
+	defer res.Body.Close()
+
+	log.Debugf("broadcast %s: status code %d for tx %s\n", validator.KoinosAddress, res.StatusCode, txId)
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+
+	bodyBytes, _ := ioutil.ReadAll(res.Body)
+	body := string(bodyBytes)
+	if res.StatusCode != http.StatusOK {
+		log.Warnf("client: received error %s\n", body)
+		tracing.SetError(span, fmt.Errorf("status code %d: %s", res.StatusCode, body))
+		return "", nil
+	}
+
+	return body, nil
+}
+
+// Handshake is the configuration a validator advertises to its peers,
+// the signatures expiration and the contracts must be the same on all the validators
+type Handshake struct {
+	KoinosAddress        string `json:"koinosAddress"`
+	EthereumAddress      string `json:"ethereumAddress"`
+	SignaturesExpiration uint   `json:"signaturesExpiration"`
+	KoinosContract       string `json:"koinosContract"`
+	// contract of the primary EVM chain, kept for the peers advertising a single chain
+	EthereumContract string `json:"ethereumContract"`
+	// contracts of every EVM chain, keyed by chain name
+	EvmContracts map[string]HandshakeEvmContract `json:"evmContracts"`
+}
+
+// HandshakeEvmContract is the bridge contract of an EVM chain advertised in the handshake
+type HandshakeEvmContract struct {
+	ChainId  uint64 `json:"chainId"`
+	Contract string `json:"contract"`
+}
+
+// NewHandshake creates the handshake of a validator, evmContracts are keyed by chain name
+// and ethereumContract is the contract of the primary EVM chain
+func NewHandshake(koinosAddress string, ethereumAddress string, signaturesExpiration uint, koinosContract string, ethereumContract string, evmContracts map[string]HandshakeEvmContract) *Handshake {
+	contracts := make(map[string]HandshakeEvmContract)
+	for name, contract := range evmContracts {
+		contracts[name] = HandshakeEvmContract{
+			ChainId:  contract.ChainId,
+			Contract: common.HexToAddress(contract.Contract).Hex(),
+		}
+	}
+
+	return &Handshake{
+		KoinosAddress:        koinosAddress,
+		EthereumAddress:      ethereumAddress,
+		SignaturesExpiration: signaturesExpiration,
+		KoinosContract:       koinosContract,
+		EthereumContract:     common.HexToAddress(ethereumContract).Hex(),
+		EvmContracts:         contracts,
+	}
+}
+
+// compareEvmContracts returns an error if the EVM chains of peer differ from the local ones
+func (local *Handshake) compareEvmContracts(peer *Handshake, peerAddress string) error {
+	// a peer not advertising its chains only has the primary one
+	if peer.EvmContracts == nil {
+		if len(local.EvmContracts) > 1 {
+			return fmt.Errorf("validator %s does not advertise its evm chains, local node bridges %d evm chains", peerAddress, len(local.EvmContracts))
+		}
+
+		if peer.EthereumContract != local.EthereumContract {
+			return fmt.Errorf("validator %s uses the ethereum contract %s, local ethereum contract is %s", peerAddress, peer.EthereumContract, local.EthereumContract)
+		}
+
+		return nil
+	}
+
+	for name, localContract := range local.EvmContracts {
+		peerContract, found := peer.EvmContracts[name]
+		if !found {
+			return fmt.Errorf("validator %s does not bridge the evm chain %s", peerAddress, name)
+		}
+
+		if peerContract.ChainId != localContract.ChainId {
+			return fmt.Errorf("validator %s uses the chain id %d for the evm chain %s, local chain id is %d", peerAddress, peerContract.ChainId, name, localContract.ChainId)
+		}
+
+		if peerContract.Contract != localContract.Contract {
+			return fmt.Errorf("validator %s uses the contract %s on the evm chain %s, local contract is %s", peerAddress, peerContract.Contract, name, localContract.Contract)
+		}
+	}
+
+	for name := range peer.EvmContracts {
+		if _, found := local.EvmContracts[name]; !found {
+			return fmt.Errorf("validator %s bridges the evm chain %s which is not configured locally", peerAddress, name)
+		}
+	}
+
+	return nil
+}
+
+// CheckPeersHandshake gets the handshake of every peer and returns an error if one of them
+// advertises a configuration different from the local one, unreachable peers are skipped
+func CheckPeersHandshake(local *Handshake, validators map[string]ValidatorConfig) error {
+	processedApiUrls := make(map[string]bool)
+
+	client := http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	for _, validator := range validators {
+		// don't check yourself
+		if validator.KoinosAddress == local.KoinosAddress {
+			continue
+		}
+
+		// since the map has the validators ethereum addresses and koinos addresses as key
+		// make sure to not check twice the same node
+		_, found := processedApiUrls[validator.ApiUrl]
+		if found {
+			continue
+		}
+		processedApiUrls[validator.ApiUrl] = true
+
+		res, err := client.Get(validator.ApiUrl + "/GetHandshake")
+		if err != nil {
+			log.Warnf("handshake: error making http request to %s: %s", validator.KoinosAddress, err)
+			continue
+		}
+
+		bodyBytes, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
 		if err != nil {
-			log.Errorf("client: could not create request: %s\n", err)
+			log.Warnf("handshake: error reading response of %s: %s", validator.KoinosAddress, err)
 			continue
 		}
-		req.Header.Set("Content-Type", "application/json")
 
-		client := http.Client{
-			Timeout: 30 * time.Second,
+		if res.StatusCode != http.StatusOK {
+			log.Warnf("handshake: %s returned status code %d", validator.KoinosAddress, res.StatusCode)
+			continue
 		}
 
-		res, err := client.Do(req)
+		peer := &Handshake{}
+		err = json.Unmarshal(bodyBytes, peer)
 		if err != nil {
-			log.Errorf("client: error making http request to %s: %s\n", validator.KoinosAddress, err)
+			log.Warnf("handshake: invalid response from %s: %s", validator.KoinosAddress, err)
 			continue
 		}
 
-		log.Debugf("broadcast %s: status code %d for tx %s\n", validator.KoinosAddress, res.StatusCode, tx.Id)
-		bodyBytes, _ := ioutil.ReadAll(res.Body)
-		body := string(bodyBytes)
-		if res.StatusCode == http.StatusOK {
-			if body != "" {
-				log.Debugf("client: received signature %s\n", body)
-				signatures[validator.KoinosAddress] = body
-			}
-		} else {
-			log.Warnf("client: received error %s\n", body)
+		if peer.SignaturesExpiration != local.SignaturesExpiration {
+			return fmt.Errorf("validator %s uses a signatures-expiration of %d, local signatures-expiration is %d", validator.KoinosAddress, peer.SignaturesExpiration, local.SignaturesExpiration)
 		}
 
-		processedApiUrls[validator.ApiUrl] = true
+		if peer.KoinosContract != local.KoinosContract {
+			return fmt.Errorf("validator %s uses the koinos contract %s, local koinos contract is %s", validator.KoinosAddress, peer.KoinosContract, local.KoinosContract)
+		}
+
+		err = local.compareEvmContracts(peer, validator.KoinosAddress)
+		if err != nil {
+			return err
+		}
+
+		log.Infof("handshake: validator %s configuration matches", validator.KoinosAddress)
 	}
 
-	return signatures, nil
+	return nil
+}
+
+// SendAlert logs the alert and, if a webhook is configured, posts it as JSON to the webhook
+func SendAlert(webhookUrl string, title string, details interface{}) {
+	log.Errorf("ALERT %s: %+v", title, details)
+
+	if webhookUrl == "" {
+		return
+	}
+
+	alertBytes, err := json.Marshal(map[string]interface{}{
+		"title":     title,
+		"details":   details,
+		"timestamp": time.Now().UnixMilli(),
+	})
+	if err != nil {
+		log.Errorf("alert: could not marshal alert: %s", err)
+		return
+	}
+
+	client := http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	res, err := client.Post(webhookUrl, "application/json", bytes.NewReader(alertBytes))
+	if err != nil {
+		log.Errorf("alert: error making http request to %s: %s", webhookUrl, err)
+		return
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		log.Warnf("alert: webhook returned status code %d", res.StatusCode)
+	}
 }
 
-func GenerateEthereumCompleteTransferHash(txIdBytes []byte, operationId uint64, ethToken []byte, recipient []byte, relayer []byte, paymentStr string, amountStr string, ethContractAddress common.Address, metadataStr string, expiration uint64, chainId uint64) (common.Hash, common.Hash) {
+// GenerateEthereumCompleteTransferHash returns the hash of a transfer completion on an EVM chain,
+// its prefixed hash (the hash signed) and the data hashed
+func GenerateEthereumCompleteTransferHash(txIdBytes []byte, operationId uint64, ethToken []byte, recipient []byte, relayer []byte, paymentStr string, amountStr string, ethContractAddress common.Address, metadataStr string, expiration uint64, chainId uint64) (common.Hash, common.Hash, []byte) {
 	amount, err := strconv.ParseUint(amountStr, 0, 64)
 	if err != nil {
 		log.Error(err.Error())
@@ -288,7 +709,7 @@ func GenerateEthereumCompleteTransferHash(txIdBytes []byte, operationId uint64,
 	}
 	metadata := []byte(metadataStr)
 
-	hash := crypto.Keccak256Hash(
+	hashedData := bytes.Join([][]byte{
 		common.LeftPadBytes(big.NewInt(int64(bridge_pb.ActionId_complete_transfer.Number())).Bytes(), 32),
 		txIdBytes,
 		common.LeftPadBytes(big.NewInt(int64(operationId)).Bytes(), 32),
@@ -301,12 +722,17 @@ func GenerateEthereumCompleteTransferHash(txIdBytes []byte, operationId uint64,
 		ethContractAddress.Bytes(),
 		common.LeftPadBytes(big.NewInt(int64(expiration)).Bytes(), 32),
 		common.LeftPadBytes(big.NewInt(int64(chainId)).Bytes(), 4),
-	)
+	}, nil)
+
+	hash := crypto.Keccak256Hash(hashedData)
 
-	prefixedHash := crypto.Keccak256Hash(
+	return hash, EthereumPrefixedHash(hash), hashedData
+}
+
+// EthereumPrefixedHash returns the hash signed for hash, prefixed as an Ethereum signed message
+func EthereumPrefixedHash(hash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(
 		[]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%v", len(hash))),
 		hash.Bytes(),
 	)
-
-	return hash, prefixedHash
 }
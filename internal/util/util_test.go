@@ -0,0 +1,72 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/koinos-bridge/koinos-bridge-validator/internal/store"
+	"github.com/koinos-bridge/koinos-bridge-validator/proto/build/github.com/koinos-bridge/koinos-bridge-validator/bridge_pb"
+)
+
+func TestSigningAuditLogVerifiesEndToEnd(t *testing.T) {
+	backend := store.NewMapBackend()
+	auditLog, err := store.NewSigningAuditLogStore(backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ethereumPK, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	koinosPK := bytes.Repeat([]byte{1}, 32)
+
+	source := &bridge_pb.SigningAuditEntry{Chain: "ethereum", BlockNumber: 10, TransactionId: "0x01", Index: 1, Event: "TokensLockedEvent"}
+
+	hashedData := []byte("complete transfer")
+	koinosHash := sha256.Sum256(hashedData)
+	SignKoinosHash(context.Background(), auditLog, source, koinosPK, hashedData, koinosHash[:])
+
+	ethereumHash := EthereumPrefixedHash(crypto.Keccak256Hash(hashedData))
+	SignEthereumHash(context.Background(), auditLog, source, ethereumPK, hashedData, ethereumHash.Bytes())
+
+	count, err := auditLog.Verify(VerifySigningAuditEntrySignature)
+	if err != nil || count != 2 {
+		t.Fatalf("expected the 2 signatures to verify, got %d, %v", count, err)
+	}
+
+	// an entry chained correctly but with a signature of another key is detected
+	otherPK, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	forged, err := store.NewSigningAuditLogStore(store.NewMapBackend())
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature, err := crypto.Sign(ethereumHash.Bytes(), otherPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature[crypto.RecoveryIDOffset] += 27
+
+	_, err = forged.Append(&bridge_pb.SigningAuditEntry{
+		KeyType:    KeyTypeEthereum,
+		Signer:     crypto.PubkeyToAddress(ethereumPK.PublicKey).Hex(),
+		HashedData: hashedData,
+		Hash:       ethereumHash.Bytes(),
+		Signature:  signature,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = forged.Verify(VerifySigningAuditEntrySignature); err == nil {
+		t.Fatal("expected a signature of another key to be detected")
+	}
+}